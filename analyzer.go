@@ -25,14 +25,66 @@ func NewAnalyzerWithConfig(config *types.Config) *analysis.Analyzer {
 // NewAnalyzer returns an Analyzer - the package interface with nogo
 func NewAnalyzer() *analysis.Analyzer {
 	config := &types.Config{
-		SuppressLen:          false,
-		SuppressNil:          false,
-		SuppressErr:          false,
-		SuppressCompare:      false,
-		ForbidFocus:          false,
-		AllowHaveLen0:        false,
-		ForceExpectTo:        false,
-		ForceSucceedForFuncs: false,
+		SuppressLen:                  false,
+		SuppressNil:                  false,
+		SuppressErr:                  false,
+		SuppressCompare:              false,
+		ForbidFocus:                  false,
+		ForbidPending:                false,
+		AllowHaveLen0:                false,
+		AllowHaveCap0:                false,
+		ForceExpect:                  false,
+		ForceExpectTo:                false,
+		ForceSucceedForFuncs:         false,
+		ForceToNot:                   false,
+		ForbidBoolLiteral:            false,
+		ForbidErrorsAs:               false,
+		ForbidSleepBeforeAssertion:   false,
+		ForbidUnguardedIndex:         false,
+		ForceMatchJSON:               false,
+		ForbidDiscardedError:         false,
+		ForceKnownLength:             false,
+		ForbidGoroutineSharedVar:     false,
+		ForbidTestingStateAssertion:  false,
+		ForbidUnguardedDeref:         false,
+		ForbidChannelLenAssertion:    false,
+		ForbidLoopVarCapture:         false,
+		ForbidAssertionResult:        false,
+		ForbidRedundantSucceed:       false,
+		ForbidDeferRecover:           false,
+		ForbidMethodExpression:       false,
+		ForbidRuneCount:              false,
+		ForceExactElements:           false,
+		ForbidAsyncBlocking:          false,
+		ForbidBufferBytes:            false,
+		ForbidStructPointerEqual:     false,
+		ForbidTypedNilInterface:      false,
+		ForbidProtoEqual:             false,
+		ForbidDeferredAssertion:      false,
+		ForbidNumericBounds:          false,
+		ForbidSQLNullNoValid:         false,
+		ForceHaveKeyWithValue:        false,
+		ForceContainElementHaveField: false,
+		ForbidAsyncDiscardedError:    false,
+		ForbidImplicitTupleError:     false,
+		ForbidContextDoneMisuse:      false,
+		ForbidLargeArrayEqual:        false,
+		LargeArrayLen:                1024,
+		ForbidFloatIntCompare:        false,
+		ForceMatchErrorStringMatcher: false,
+		ForbidMapOrderEqual:          false,
+		ForceFileMatchers:            false,
+		ForbidStrconvFormatEqual:     false,
+		ForbidReversedEqual:          false,
+		WarnFloatEqual:               false,
+		ForceSatisfyAll:              false,
+		SuppressEquivalentTo:         false,
+		ForbidConsistentlyReceive:    false,
+		ForbidSharedMatcher:          false,
+		ForbidErrorSliceEqual:        false,
+		ForceWithTransform:           false,
+		ForbidExpectWithOffset:       false,
+		ForbidTimeStructEqual:        false,
 	}
 
 	a := NewAnalyzerWithConfig(config)
@@ -46,10 +98,64 @@ func NewAnalyzer() *analysis.Analyzer {
 	a.Flags.BoolVar(&config.ValidateAsyncIntervals, "validate-async-intervals", config.ValidateAsyncIntervals, "best effort validation of async intervals (timeout and polling); ignored the suppress-async-assertion flag is true")
 	a.Flags.BoolVar(&config.SuppressTypeCompare, "suppress-type-compare-assertion", config.SuppressTypeCompare, "Suppress warning for comparing values from different types, like int32 and uint32")
 	a.Flags.BoolVar(&config.AllowHaveLen0, "allow-havelen-0", config.AllowHaveLen0, "Do not warn for HaveLen(0); default = false")
+	a.Flags.BoolVar(&config.AllowHaveCap0, "allow-havecap-0", config.AllowHaveCap0, "Do not warn for HaveCap(0); default = false")
+	a.Flags.BoolVar(&config.ForceExpect, "force-expect", config.ForceExpect, "force using `Expect` as the actual function of the synchronous assertions. reject using `Ω`; ignored if the assertion-style flag is set; default = false (not forced)")
 	a.Flags.BoolVar(&config.ForceExpectTo, "force-expect-to", config.ForceExpectTo, "force using `Expect` with `To`, `ToNot` or `NotTo`. reject using `Expect` with `Should` or `ShouldNot`; default = false (not forced)")
 	a.Flags.BoolVar(&config.ForbidFocus, "forbid-focus-container", config.ForbidFocus, "trigger a warning for ginkgo focus containers like FDescribe, FContext, FWhen or FIt; default = false.")
+	a.Flags.BoolVar(&config.ForbidPending, "forbid-pending-container", config.ForbidPending, "trigger a warning for ginkgo pending containers like PDescribe, XDescribe, PIt or XIt, that will not run (default = false)")
 	a.Flags.BoolVar(&config.ForbidSpecPollution, "forbid-spec-pollution", config.ForbidSpecPollution, "trigger a warning for variable assignments in ginkgo containers like Describe, Context and When, instead of in BeforeEach(); default = false.")
 	a.Flags.BoolVar(&config.ForceSucceedForFuncs, "force-succeed", config.ForceSucceedForFuncs, "force using the Succeed matcher for error functions, and the HaveOccurred matcher for non-function error values")
+	a.Flags.BoolVar(&config.ForceToNot, "force-tonot", config.ForceToNot, "force using `ToNot` or `ShouldNot` instead of wrapping the matcher with `Not`; e.g. `To(Not(BeNil()))`; default = false (not forced)")
+	a.Flags.BoolVar(&config.ForbidBoolLiteral, "forbid-bool-literal-actual", config.ForbidBoolLiteral, "trigger a warning for assertions with a true or false literal as the actual value, like Expect(true); default = false.")
+	a.Flags.BoolVar(&config.ForbidErrorsAs, "forbid-errors-as", config.ForbidErrorsAs, "trigger a warning for boolean assertions of errors.As, that populates its target as a side effect; default = false.")
+	a.Flags.BoolVar(&config.ForbidSleepBeforeAssertion, "forbid-sleep-before-assertion", config.ForbidSleepBeforeAssertion, "trigger a warning for a time.Sleep call, that is immediately followed by an assertion, instead of using Eventually; default = false.")
+	a.Flags.BoolVar(&config.ForbidUnguardedIndex, "forbid-unguarded-index", config.ForbidUnguardedIndex, "trigger a warning for assertions of a constant index of a slice, like Expect(s[0]), with no length assertion before it in the same block; default = false.")
+	a.Flags.BoolVar(&config.ForceMatchJSON, "force-match-json", config.ForceMatchJSON, "trigger a warning for the Equal matcher with a JSON string literal, and suggest the MatchJSON matcher instead; default = false.")
+	a.Flags.BoolVar(&config.ForbidDiscardedError, "forbid-discarded-error", config.ForbidDiscardedError, "trigger a warning for assertions of a value, when the error returned with it, in the previous statement, was discarded; default = false.")
+	a.Flags.Var(&config.MatcherAliases, "matcher-aliases", "comma separated list of custom wrapper matchers, and the gomega matchers they wrap, to apply the same rules on the wrappers; e.g. MyEqual=Equal,BeEmptyList=BeEmpty. The wrapper must receive the same parameters as the gomega matcher")
+	a.Flags.Var(&config.AssertionStyle, "assertion-style", "force one style of the synchronous assertions: either gomega-expect, for Expect(x).To(...), or gomega-omega, for Ω(x).Should(...); default = both styles are allowed")
+	a.Flags.BoolVar(&config.ForceKnownLength, "force-known-length", config.ForceKnownLength, "force using HaveLen instead of Not(BeEmpty()), when the slice length is known from the appends before the assertion (default = false)")
+	a.Flags.BoolVar(&config.ForbidGoroutineSharedVar, "forbid-goroutine-shared-var", config.ForbidGoroutineSharedVar, "trigger a warning for synchronous assertions of a variable, that is written by a goroutine started before in the same block (default = false)")
+	a.Flags.BoolVar(&config.ForbidTestingStateAssertion, "forbid-testing-state-assertion", config.ForbidTestingStateAssertion, "trigger a warning for assertions of the testing framework state, like Expect(t.Failed()).To(BeFalse()) (default = false)")
+	a.Flags.BoolVar(&config.ForbidUnguardedDeref, "forbid-unguarded-deref", config.ForbidUnguardedDeref, "trigger a warning for assertions of a pointer dereference, with no not-nil assertion of the pointer before, in the same block (default = false)")
+	a.Flags.BoolVar(&config.ForbidChannelLenAssertion, "forbid-channel-len-assertion", config.ForbidChannelLenAssertion, "trigger a warning for synchronous assertions of the length of a channel (default = false)")
+	a.Flags.BoolVar(&config.ForbidLoopVarCapture, "forbid-loop-var-capture", config.ForbidLoopVarCapture, "trigger a warning when a spec closure, like It, uses a loop variable, when the go version is older than go1.22 (default = false)")
+	a.Flags.BoolVar(&config.ForbidAssertionResult, "forbid-assertion-result", config.ForbidAssertionResult, "trigger a warning for an assertion result that is assigned to a variable, or used as a condition; gomega already fails the test if the assertion fails (default = false)")
+	a.Flags.BoolVar(&config.ForbidRedundantSucceed, "forbid-redundant-succeed", config.ForbidRedundantSucceed, "trigger a warning for a Succeed() assertion of a function call, when the error of the same call is also stored in a variable that is checked, in the same block (default = false)")
+	a.Flags.BoolVar(&config.ForbidDeferRecover, "forbid-defer-recover", config.ForbidDeferRecover, "trigger a warning for assertions of the recover() result in a deferred function, instead of using the Panic or the PanicWith matchers (default = false)")
+	a.Flags.BoolVar(&config.ForbidMethodExpression, "forbid-method-expression", config.ForbidMethodExpression, "trigger a warning for assertions of a method expression, like Expect(T.Method), that is not bound to any receiver (default = false)")
+	a.Flags.BoolVar(&config.ForbidRuneCount, "forbid-rune-count", config.ForbidRuneCount, "trigger a warning for equality assertions of utf8.RuneCountInString or utf8.RuneCount, that may be confused with the length in bytes (default = false)")
+	a.Flags.BoolVar(&config.ForceExactElements, "force-exact-elements", config.ForceExactElements, "force using the HaveExactElements matcher, instead of Equal with a slice literal (default = false)")
+	a.Flags.BoolVar(&config.ForbidAsyncBlocking, "forbid-async-blocking", config.ForbidAsyncBlocking, "trigger a warning for Eventually or Consistently with a function that only runs a known blocking operation, like a channel receive (default = false)")
+	a.Flags.BoolVar(&config.ForbidBufferBytes, "forbid-buffer-bytes", config.ForbidBufferBytes, "trigger a warning for Equal assertions of the Bytes() of a bytes.Buffer, instead of its String() (default = false)")
+	a.Flags.BoolVar(&config.ForbidStructPointerEqual, "forbid-struct-pointer-equal", config.ForbidStructPointerEqual, "trigger an informational warning for Equal assertions of a struct with a pointer field, because Equal compares the pointed values (default = false)")
+	a.Flags.BoolVar(&config.ForbidTypedNilInterface, "forbid-typed-nil-interface", config.ForbidTypedNilInterface, "trigger a warning for BeNil() assertions of an interface, that is assigned with a value of a concrete pointer type, in the same block (default = false)")
+	a.Flags.BoolVar(&config.ForbidProtoEqual, "forbid-proto-equal", config.ForbidProtoEqual, "trigger an informational warning for Equal assertions of a protobuf message, because Equal also compares the internal state of the message (default = false)")
+	a.Flags.BoolVar(&config.ForbidDeferredAssertion, "forbid-deferred-assertion", config.ForbidDeferredAssertion, "trigger a warning for gomega assertions that are deferred with a defer statement, instead of using DeferCleanup (default = false)")
+	a.Flags.BoolVar(&config.ForbidNumericBounds, "forbid-numeric-bounds", config.ForbidNumericBounds, "trigger a warning for two assertions of the same variable, with the \">=\" and the \"<=\" BeNumerically bounds of the same value, instead of using Equal (default = false)")
+	a.Flags.BoolVar(&config.ForbidSQLNullNoValid, "forbid-sql-null-no-valid", config.ForbidSQLNullNoValid, "trigger an informational warning for Equal assertions with an expected sql.Null* literal, that sets the value, but not the Valid field (default = false)")
+	a.Flags.BoolVar(&config.ForceHaveKeyWithValue, "force-have-key-with-value", config.ForceHaveKeyWithValue, "trigger a warning for a HaveKey assertion of a map, followed by an Equal assertion of the same key, instead of a single HaveKeyWithValue assertion (default = false)")
+	a.Flags.BoolVar(&config.ForceContainElementHaveField, "force-contain-element-have-field", config.ForceContainElementHaveField, "trigger an informational warning for ContainElement(Equal()) assertions of a struct literal, suggesting the HaveField matcher (default = false)")
+	a.Flags.BoolVar(&config.ForbidAsyncDiscardedError, "forbid-async-discarded-error", config.ForbidAsyncDiscardedError, "trigger a warning for Eventually and Consistently function literals, that assign the error of a call to the blank identifier (default = false)")
+	a.Flags.BoolVar(&config.ForbidImplicitTupleError, "forbid-implicit-tuple-error", config.ForbidImplicitTupleError, "trigger a warning for assertions of a function call that returns a value and an error, with a value matcher, that only implicitly asserts that the error is nil (default = false)")
+	a.Flags.BoolVar(&config.ForbidContextDoneMisuse, "forbid-context-done-misuse", config.ForbidContextDoneMisuse, "trigger a warning for assertions of the Done() channel of a context, with a matcher other than BeClosed (default = false)")
+	a.Flags.BoolVar(&config.ForbidLargeArrayEqual, "forbid-large-array-equal", config.ForbidLargeArrayEqual, "trigger an informational warning for Equal assertions of an array actual value, that is longer than large-array-len, because the array is copied by value (default = false)")
+	a.Flags.IntVar(&config.LargeArrayLen, "large-array-len", config.LargeArrayLen, "the maximal length of an array, that the forbid-large-array-equal flag does not report (default = 1024)")
+	a.Flags.BoolVar(&config.ForbidFloatIntCompare, "forbid-float-int-compare", config.ForbidFloatIntCompare, "trigger an informational warning for Equal or BeNumerically assertions of a float actual value, with a non-constant integer expected value, that may lose precision when converted to float (default = false)")
+	a.Flags.BoolVar(&config.ForceMatchErrorStringMatcher, "force-match-error-string-matcher", config.ForceMatchErrorStringMatcher, "trigger a warning for string matcher assertions of the Error() string of an error, like ContainSubstring, suggesting to wrap the matcher with MatchError (default = false)")
+	a.Flags.BoolVar(&config.ForbidMapOrderEqual, "forbid-map-order-equal", config.ForbidMapOrderEqual, "trigger a warning for Equal assertions of a slice, that is built by appending the keys or the values of a map, in a range loop, in the same block; suggesting the ConsistOf matcher (default = false)")
+	a.Flags.BoolVar(&config.ForceFileMatchers, "force-file-matchers", config.ForceFileMatchers, "trigger a warning for assertions of the result of os.Stat, in the same block, like the IsDir() method of the returned file info, or the returned error, suggesting the BeADirectory, BeARegularFile or BeAnExistingFile matchers (default = false)")
+	a.Flags.BoolVar(&config.ForbidStrconvFormatEqual, "forbid-strconv-format-equal", config.ForbidStrconvFormatEqual, "trigger an informational warning for Equal assertions of the result of strconv.Itoa or strconv.FormatInt, with a string literal, suggesting to assert the numeric value itself (default = false)")
+	a.Flags.BoolVar(&config.ForbidReversedEqual, "forbid-reversed-equal", config.ForbidReversedEqual, "trigger a warning for Equal assertions of a constant actual value, with a non-constant expected value, that their failure message reads backwards (default = false)")
+	a.Flags.BoolVar(&config.WarnFloatEqual, "warn-float-equal", config.WarnFloatEqual, "trigger a warning for Equal assertions of any float actual value, with a float expected value, suggesting BeNumerically(\"~\", ...); by default, only computed float values are reported (default = false)")
+	a.Flags.BoolVar(&config.ForceSatisfyAll, "force-satisfy-all", config.ForceSatisfyAll, "trigger a warning for consecutive assertions of the same actual value, instead of a single SatisfyAll assertion (default = false)")
+	a.Flags.BoolVar(&config.SuppressEquivalentTo, "suppress-equivalent-to", config.SuppressEquivalentTo, "Suppress warning for BeEquivalentTo assertions of values of the same type, that should use Equal instead")
+	a.Flags.BoolVar(&config.ForbidConsistentlyReceive, "forbid-consistently-receive", config.ForbidConsistentlyReceive, "trigger a warning for Consistently with a function that receives from a channel, that drains the channel on each polling (default = false)")
+	a.Flags.BoolVar(&config.ForbidSharedMatcher, "forbid-shared-matcher", config.ForbidSharedMatcher, "trigger a warning for a gomega matcher in a variable, that is used by more than one assertion (default = false)")
+	a.Flags.BoolVar(&config.ForbidErrorSliceEqual, "forbid-error-slice-equal", config.ForbidErrorSliceEqual, "trigger a warning for Equal or ConsistOf assertions of a slice of errors, that do not support wrapped errors (default = false)")
+	a.Flags.BoolVar(&config.ForceWithTransform, "force-with-transform", config.ForceWithTransform, "trigger a warning for assertions of a transformed actual value, like strings.ToLower(s), suggesting the WithTransform matcher (default = false)")
+	a.Flags.BoolVar(&config.ForbidExpectWithOffset, "forbid-expect-with-offset", config.ForbidExpectWithOffset, "trigger a warning for ExpectWithOffset, EventuallyWithOffset and ConsistentlyWithOffset, suggesting the WithOffset method or GinkgoHelper (default = false)")
+	a.Flags.BoolVar(&config.ForbidTimeStructEqual, "forbid-time-struct-equal", config.ForbidTimeStructEqual, "trigger a warning for Equal assertions of a struct with a time.Time field, that may fail for the same instant (default = false)")
 
 	return a
 }
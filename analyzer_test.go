@@ -21,6 +21,10 @@ func TestAllUseCases(t *testing.T) {
 			testName: "find HaveLen(0)",
 			testData: "a/havelen0",
 		},
+		{
+			testName: "find HaveCap(0)",
+			testData: "a/havecap0",
+		},
 		{
 			testName: "find wrong nil assertion",
 			testData: "a/nil",
@@ -109,6 +113,122 @@ func TestAllUseCases(t *testing.T) {
 			testName: "matchError with func return error-func",
 			testData: "a/issue-174",
 		},
+		{
+			testName: "errors.Is assertion",
+			testData: "a/errorsis",
+		},
+		{
+			testName: "duration literals in BeNumerically",
+			testData: "a/durationliteral",
+		},
+		{
+			testName: "comparing sync types",
+			testData: "a/synccopy",
+		},
+		{
+			testName: "fmt.Sprintf with no verbs in matchers",
+			testData: "a/sprintfnoverbs",
+		},
+		{
+			testName: "redundant type conversions",
+			testData: "a/redundantconversion",
+		},
+		{
+			testName: "chain of equality comparisons",
+			testData: "a/elementof",
+		},
+		{
+			testName: "table entries parameters",
+			testData: "a/tableentries",
+		},
+		{
+			testName: "unsigned compared with a negative constant",
+			testData: "a/negativeunsigned",
+		},
+		{
+			testName: "BeNumerically == of a non-float value",
+			testData: "a/numericequal",
+		},
+		{
+			testName: "comparison of incomparable values",
+			testData: "a/incomparable",
+		},
+		{
+			testName: "nested assertions",
+			testData: "a/nestedassertion",
+		},
+		{
+			testName: "Equal of a computed float value",
+			testData: "a/floatequal",
+		},
+		{
+			testName: "constant actual values",
+			testData: "a/constantactual",
+		},
+		{
+			testName: "os.IsNotExist style error checks",
+			testData: "a/osiserror",
+		},
+		{
+			testName: "strings.Contains, strings.HasPrefix and strings.HasSuffix assertions",
+			testData: "a/stringsfunc",
+		},
+		{
+			testName: "regexp.MatchString assertions",
+			testData: "a/regexpmatch",
+		},
+		{
+			testName: "reflect.DeepEqual assertions",
+			testData: "a/deepequal",
+		},
+		{
+			testName: "time.Time comparison method assertions",
+			testData: "a/timecompare",
+		},
+		{
+			testName: "BeEquivalentTo of values of the same type",
+			testData: "a/equivalentto",
+		},
+		{
+			testName: "string index compared with a string",
+			testData: "a/stringindex",
+		},
+		{
+			testName: "Equal of an error with a wrapped error",
+			testData: "a/wrappederrorequal",
+		},
+		{
+			testName: "Equal assertions of an error message",
+			testData: "a/errorstring",
+		},
+		{
+			testName: "Equal assertions of time.Now()",
+			testData: "a/timenowequal",
+		},
+		{
+			testName: "swapped actual value and matcher",
+			testData: "a/swappedmatcher",
+		},
+		{
+			testName: "custom types that embed gomega",
+			testData: "a/gomegawrapper",
+		},
+		{
+			testName: "negated actual value of a boolean assertion",
+			testData: "a/negatedactual",
+		},
+		{
+			testName: "local variables that shadow gomega functions",
+			testData: "a/shadowedgomega",
+		},
+		{
+			testName: "assertion of the results of sync.Map methods",
+			testData: "a/syncmapload",
+		},
+		{
+			testName: "async function with assertions and no Gomega argument",
+			testData: "a/asyncgomegaparam",
+		},
 	} {
 		t.Run(tc.testName, func(tt *testing.T) {
 			analysistest.Run(tt, analysistest.TestData(), ginkgolinter.NewAnalyzer(), tc.testData)
@@ -147,6 +267,11 @@ func TestFlags(t *testing.T) {
 			testData: []string{"a/havelen0config"},
 			flags:    map[string]string{"allow-havelen-0": "true"},
 		},
+		{
+			testName: "test the allow-havecap-0 flag",
+			testData: []string{"a/havecap0config"},
+			flags:    map[string]string{"allow-havecap-0": "true"},
+		},
 		{
 			testName: "test the suppress-async-assertion flag",
 			testData: []string{"a/asyncconfig"},
@@ -157,6 +282,11 @@ func TestFlags(t *testing.T) {
 			testData: []string{"a/focusconfig"},
 			flags:    map[string]string{"forbid-focus-container": "true"},
 		},
+		{
+			testName: "test the forbid-pending-container flag",
+			testData: []string{"a/pendingconfig"},
+			flags:    map[string]string{"forbid-pending-container": "true"},
+		},
 		{
 			testName: "test the suppress-type-compare-assertion flag",
 			testData: []string{"a/comparetypesconfig"},
@@ -167,6 +297,11 @@ func TestFlags(t *testing.T) {
 			testData: []string{"a/forceExpectTo"},
 			flags:    map[string]string{"force-expect-to": "true"},
 		},
+		{
+			testName: "test the force-expect flag",
+			testData: []string{"a/forceexpect"},
+			flags:    map[string]string{"force-expect": "true"},
+		},
 		{
 			testName: "check async timing intervals",
 			testData: []string{"a/timing"},
@@ -192,6 +327,266 @@ func TestFlags(t *testing.T) {
 				"force-succeed": "true",
 			},
 		},
+		{
+			testName: "force ToNot instead of Not()",
+			testData: []string{"a/forcetonot"},
+			flags:    map[string]string{"force-tonot": "true"},
+		},
+		{
+			testName: "forbid bool literal actual",
+			testData: []string{"a/boolliteral"},
+			flags:    map[string]string{"forbid-bool-literal-actual": "true"},
+		},
+		{
+			testName: "forbid errors.As assertion",
+			testData: []string{"a/errorsas"},
+			flags:    map[string]string{"forbid-errors-as": "true"},
+		},
+		{
+			testName: "forbid time.Sleep before assertion",
+			testData: []string{"a/sleepbeforeassertion"},
+			flags:    map[string]string{"forbid-sleep-before-assertion": "true"},
+		},
+		{
+			testName: "forbid unguarded slice index",
+			testData: []string{"a/unguardedindex"},
+			flags:    map[string]string{"forbid-unguarded-index": "true"},
+		},
+		{
+			testName: "force MatchJSON",
+			testData: []string{"a/matchjson"},
+			flags:    map[string]string{"force-match-json": "true"},
+		},
+		{
+			testName: "forbid discarded error",
+			testData: []string{"a/discardederror"},
+			flags:    map[string]string{"forbid-discarded-error": "true"},
+		},
+		{
+			testName: "matcher aliases",
+			testData: []string{"a/matcheraliases"},
+			flags:    map[string]string{"matcher-aliases": "MyEqual=Equal,BeEmptyList=BeEmpty"},
+		},
+		{
+			testName: "force HaveLen for known length",
+			testData: []string{"a/knownlength"},
+			flags:    map[string]string{"force-known-length": "true"},
+		},
+		{
+			testName: "forbid goroutine shared variables",
+			testData: []string{"a/goroutinevar"},
+			flags:    map[string]string{"forbid-goroutine-shared-var": "true"},
+		},
+		{
+			testName: "forbid testing state assertion",
+			testData: []string{"a/testingstate"},
+			flags:    map[string]string{"forbid-testing-state-assertion": "true"},
+		},
+		{
+			testName: "forbid unguarded pointer dereference",
+			testData: []string{"a/unguardedderef"},
+			flags:    map[string]string{"forbid-unguarded-deref": "true"},
+		},
+		{
+			testName: "gomega-expect assertion style",
+			testData: []string{"a/assertionstyleexpect"},
+			flags:    map[string]string{"assertion-style": "gomega-expect"},
+		},
+		{
+			testName: "gomega-omega assertion style",
+			testData: []string{"a/assertionstyleomega"},
+			flags:    map[string]string{"assertion-style": "gomega-omega"},
+		},
+		{
+			testName: "forbid channel length assertion",
+			testData: []string{"a/channelstate"},
+			flags:    map[string]string{"forbid-channel-len-assertion": "true"},
+		},
+		{
+			testName: "forbid loop variable capture",
+			testData: []string{"a/loopvarcapture"},
+			flags:    map[string]string{"forbid-loop-var-capture": "true"},
+		},
+		{
+			testName: "forbid assertion result",
+			testData: []string{"a/assertionresult"},
+			flags:    map[string]string{"forbid-assertion-result": "true"},
+		},
+		{
+			testName: "forbid redundant Succeed",
+			testData: []string{"a/redundantsucceed"},
+			flags:    map[string]string{"forbid-redundant-succeed": "true"},
+		},
+		{
+			testName: "forbid defer recover",
+			testData: []string{"a/deferrecover"},
+			flags:    map[string]string{"forbid-defer-recover": "true"},
+		},
+		{
+			testName: "forbid method expression",
+			testData: []string{"a/methodexpression"},
+			flags:    map[string]string{"forbid-method-expression": "true"},
+		},
+		{
+			testName: "forbid rune count",
+			testData: []string{"a/runecount"},
+			flags:    map[string]string{"forbid-rune-count": "true"},
+		},
+		{
+			testName: "force HaveExactElements",
+			testData: []string{"a/exactelements"},
+			flags:    map[string]string{"force-exact-elements": "true"},
+		},
+		{
+			testName: "forbid async blocking",
+			testData: []string{"a/asyncblocking"},
+			flags:    map[string]string{"forbid-async-blocking": "true"},
+		},
+		{
+			testName: "forbid buffer bytes",
+			testData: []string{"a/bufferbytes"},
+			flags:    map[string]string{"forbid-buffer-bytes": "true"},
+		},
+		{
+			testName: "forbid struct pointer equal",
+			testData: []string{"a/structpointer"},
+			flags:    map[string]string{"forbid-struct-pointer-equal": "true"},
+		},
+		{
+			testName: "forbid typed nil interface",
+			testData: []string{"a/typednil"},
+			flags:    map[string]string{"forbid-typed-nil-interface": "true"},
+		},
+		{
+			testName: "forbid Equal of protobuf messages",
+			testData: []string{"a/protoequal"},
+			flags:    map[string]string{"forbid-proto-equal": "true"},
+		},
+		{
+			testName: "forbid deferred assertions",
+			testData: []string{"a/deferredassertion"},
+			flags:    map[string]string{"forbid-deferred-assertion": "true"},
+		},
+		{
+			testName: "forbid complementary numeric bounds",
+			testData: []string{"a/numericbounds"},
+			flags:    map[string]string{"forbid-numeric-bounds": "true"},
+		},
+		{
+			testName: "forbid sql.Null literals without Valid",
+			testData: []string{"a/sqlnull"},
+			flags:    map[string]string{"forbid-sql-null-no-valid": "true"},
+		},
+		{
+			testName: "force HaveKeyWithValue",
+			testData: []string{"a/havekeywithvalue"},
+			flags:    map[string]string{"force-have-key-with-value": "true"},
+		},
+		{
+			testName: "force HaveField in ContainElement",
+			testData: []string{"a/containelementfield"},
+			flags:    map[string]string{"force-contain-element-have-field": "true"},
+		},
+		{
+			testName: "forbid discarded errors in async functions",
+			testData: []string{"a/asyncdiscardederror"},
+			flags:    map[string]string{"forbid-async-discarded-error": "true"},
+		},
+		{
+			testName: "forbid implicit error check of multiple results",
+			testData: []string{"a/tupleerror"},
+			flags:    map[string]string{"forbid-implicit-tuple-error": "true"},
+		},
+		{
+			testName: "forbid misuse of the context Done channel",
+			testData: []string{"a/contextdone"},
+			flags:    map[string]string{"forbid-context-done-misuse": "true"},
+		},
+		{
+			testName: "test the forbid-large-array-equal flag",
+			testData: []string{"a/largearrayequal"},
+			flags:    map[string]string{"forbid-large-array-equal": "true"},
+		},
+		{
+			testName: "test the large-array-len flag",
+			testData: []string{"a/largearraylen"},
+			flags:    map[string]string{"forbid-large-array-equal": "true", "large-array-len": "16"},
+		},
+		{
+			testName: "test the forbid-float-int-compare flag",
+			testData: []string{"a/floatintcompare"},
+			flags:    map[string]string{"forbid-float-int-compare": "true"},
+		},
+		{
+			testName: "test the force-match-error-string-matcher flag",
+			testData: []string{"a/matcherrorstring"},
+			flags:    map[string]string{"force-match-error-string-matcher": "true"},
+		},
+		{
+			testName: "test the forbid-map-order-equal flag",
+			testData: []string{"a/maporderequal"},
+			flags:    map[string]string{"forbid-map-order-equal": "true"},
+		},
+		{
+			testName: "test the force-file-matchers flag",
+			testData: []string{"a/filestat"},
+			flags:    map[string]string{"force-file-matchers": "true"},
+		},
+		{
+			testName: "test the forbid-strconv-format-equal flag",
+			testData: []string{"a/strconvformat"},
+			flags:    map[string]string{"forbid-strconv-format-equal": "true"},
+		},
+		{
+			testName: "test the forbid-reversed-equal flag",
+			testData: []string{"a/reversedequal"},
+			flags:    map[string]string{"forbid-reversed-equal": "true"},
+		},
+		{
+			testName: "test the warn-float-equal flag",
+			testData: []string{"a/floatequalall"},
+			flags:    map[string]string{"warn-float-equal": "true"},
+		},
+		{
+			testName: "test the force-satisfy-all flag",
+			testData: []string{"a/satisfyall"},
+			flags:    map[string]string{"force-satisfy-all": "true"},
+		},
+		{
+			testName: "test the suppress-equivalent-to flag",
+			testData: []string{"a/equivalenttoconfig"},
+			flags:    map[string]string{"suppress-equivalent-to": "true"},
+		},
+		{
+			testName: "test the forbid-consistently-receive flag",
+			testData: []string{"a/consistentlyreceive"},
+			flags:    map[string]string{"forbid-consistently-receive": "true"},
+		},
+		{
+			testName: "test the forbid-shared-matcher flag",
+			testData: []string{"a/sharedmatcher"},
+			flags:    map[string]string{"forbid-shared-matcher": "true"},
+		},
+		{
+			testName: "test the forbid-error-slice-equal flag",
+			testData: []string{"a/errorsliceequal"},
+			flags:    map[string]string{"forbid-error-slice-equal": "true"},
+		},
+		{
+			testName: "test the force-with-transform flag",
+			testData: []string{"a/withtransform"},
+			flags:    map[string]string{"force-with-transform": "true"},
+		},
+		{
+			testName: "test the forbid-expect-with-offset flag",
+			testData: []string{"a/expectwithoffset"},
+			flags:    map[string]string{"forbid-expect-with-offset": "true"},
+		},
+		{
+			testName: "test the forbid-time-struct-equal flag",
+			testData: []string{"a/timestructequal"},
+			flags:    map[string]string{"forbid-time-struct-equal": "true"},
+		},
 	} {
 		t.Run(tc.testName, func(tt *testing.T) {
 			analyzer := ginkgolinter.NewAnalyzer()
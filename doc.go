@@ -10,19 +10,24 @@ version: %s
 currently, the linter searches for following:
 * trigger a warning when using Eventually or Consistently with a function call. This is in order to prevent the case when 
   using a function call instead of a function. Function call returns a value only once, and so the original value
-  is tested again and again and is never changed. [Bug]
+  is tested again and again and is never changed. The same is true for a plain value, like an int variable. [Bug]
 
 * trigger a warning when comparing a pointer to a value. [Bug]
 
 * trigger a warning for missing assertion method: [Bug]
 	Eventually(checkSomething)
 
-* trigger a warning when a ginkgo focus container (FDescribe, FContext, FWhen or FIt) is found. [Bug]
+* trigger a warning when a ginkgo focus container (FDescribe, FContext, FWhen, FIt or FSpecify) is found. [Bug]
+
+* (optional) trigger a warning when a ginkgo pending container (like PDescribe, XDescribe, PIt or XIt) is found. [Style]
 
 * validate the MatchError gomega matcher [Bug]
 
 * trigger a warning when using the Equal or the BeIdentical matcher with two different types, as these matchers will
-  fail in runtime.
+  fail in runtime. When the types are unrelated, like bool and int, the warning says that the matcher never matches.
+  A byte slice that is compared with a constant string, is fixed by converting the expected value to a byte slice.
+  A named integer type that is compared with an integer literal, is fixed by using the constant of the named type,
+  with the same value, if there is exactly one such constant.
 
 * async timing interval: timeout is shorter than polling interval [Bug]
 For example:
@@ -52,6 +57,63 @@ Should use BeforeEach instead; e.g.
 		})
 	})
 
+* trigger a warning when the actual value is a boolean literal, like Expect(true).To(BeTrue()). [Bug]
+
+* trigger a warning for boolean assertions of errors.As, that populates its target as a side effect. [Bug]
+
+* comparing values that contain sync types, like sync.WaitGroup or sync.Mutex, by value [Bug]
+For example:
+	Expect(wg).To(Equal(sync.WaitGroup{}))
+
+* wrong number of table entry parameters, that will panic in runtime [Bug]
+For example:
+	DescribeTable("my table", func(a int, b string) {...},
+		Entry("wrong", 1),
+	)
+
+* comparing an unsigned value with a negative constant [Bug]
+For example:
+	var u uint
+	Expect(u).To(BeNumerically(">", -1)) // always passes
+
+* comparing values of an incomparable type, that are converted to interfaces [Bug]
+For example:
+	Expect(any(s1) == any(s2)).To(BeTrue())
+should be:
+	Expect(any(s1)).To(Equal(any(s2)))
+
+* assertion of the result of another assertion [Bug]
+For example:
+	Expect(Expect(x).To(Equal(y))).To(BeTrue())
+should be:
+	Expect(x).To(Equal(y))
+or, for async assertions:
+	Eventually(Expect(x).To(Equal(y))).Should(BeTrue())
+should be:
+	Eventually(func(g Gomega) { g.Expect(x).To(Equal(y)) }).Should(Succeed())
+
+* assertion of a constant arithmetic expression, with a constant expected value [Bug]
+For example:
+	Expect(2 + 2).To(Equal(4))
+
+* Equal assertion of time.Now(), that always fails [Bug]
+For example:
+	Expect(time.Now()).To(Equal(expected))
+should be:
+	Expect(time.Now()).To(BeTemporally("~", expected, time.Second))
+
+* swapped actual value and matcher, that compares the matcher itself with the value [Bug]
+For example:
+	Expect(HaveLen(3)).To(Equal(s))
+should be:
+	Expect(s).To(HaveLen(3))
+
+* Equal assertion of a string index, that is a byte, with a string value [Bug]
+For example:
+	Expect(s[0]).To(Equal("a"))
+should be:
+	Expect(s[0]).To(Equal(byte('a')))
+
 * wrong length assertions. We want to assert the item rather than its length. [Style]
 For example:
 	Expect(len(x)).Should(Equal(1))
@@ -63,6 +125,10 @@ For example:
 	Expect(cap(x)).Should(Equal(1))
 This should be replaced with:
 	Expect(x)).Should(HavelCap(1))
+A zero capacity is asserted with the BeZero matcher; e.g. Expect(cap(x) == 0).Should(BeTrue()) should be replaced with:
+	Expect(cap(x)).Should(BeZero())
+A zero capacity is rarely meaningful, so the HaveCap(0) matcher is also reported, with no suggested fix; e.g.
+	Expect(x).Should(HaveCap(0))
 	
 * wrong nil assertions. We want to assert the item rather than a comparison result. [Style]
 For example:
@@ -75,6 +141,58 @@ This should be replaced with:
 This should be replaced with:
 	Expect(err).ShouldNot(HaveOccurred())
 
+* wrong errors.Is assertions. For example: [Style]
+	Expect(errors.Is(err, io.EOF)).Should(BeTrue())
+This should be replaced with:
+	Expect(err).Should(MatchError(io.EOF))
+
+* Equal assertion of an error, with a new fmt.Errorf error that wraps another error. For example: [Style]
+	Expect(err).Should(Equal(fmt.Errorf("wrap: %%w", inner)))
+This should be replaced with:
+	Expect(err).Should(MatchError(inner))
+
+* wrong error message assertions. For example: [Style]
+	Expect(err.Error()).Should(Equal("boom"))
+or:
+	Expect(fmt.Sprintf("%%v", err)).Should(Equal("boom"))
+This should be replaced with:
+	Expect(err).Should(MatchError("boom"))
+
+* wrong os.IsNotExist, os.IsExist or os.IsPermission assertions. For example: [Style]
+	Expect(os.IsNotExist(err)).Should(BeTrue())
+This should be replaced with:
+	Expect(err).Should(MatchError(os.ErrNotExist))
+
+* wrong strings.Contains, strings.HasPrefix or strings.HasSuffix assertions. For example: [Style]
+	Expect(strings.Contains(out, "error")).Should(BeTrue())
+This should be replaced with:
+	Expect(out).Should(ContainSubstring("error"))
+
+* wrong regexp.MatchString assertions. For example: [Style]
+	Expect(regexp.MatchString("^foo", s)).Should(BeTrue())
+This should be replaced with:
+	Expect(s).Should(MatchRegexp("^foo"))
+
+* wrong reflect.DeepEqual assertions. For example: [Style]
+	Expect(reflect.DeepEqual(a, b)).Should(BeTrue())
+This should be replaced with:
+	Expect(a).Should(Equal(b))
+
+* wrong time.Time After, Before or Equal assertions. For example: [Style]
+	Expect(t1.After(t2)).Should(BeTrue())
+This should be replaced with:
+	Expect(t1).Should(BeTemporally(">", t2))
+
+* BeEquivalentTo assertion of values of the same type. For example: [Style]
+	Expect(x).Should(BeEquivalentTo(5))
+This should be replaced with:
+	Expect(x).Should(Equal(5))
+
+* (optional) constant actual value, with a non-constant expected value. For example: [Style]
+	Expect(42).Should(Equal(x))
+This should be replaced with:
+	Expect(x).Should(Equal(42))
+
 * wrong boolean comparison, for example: [Style]
 	Expect(x == 8).Should(BeTrue())
 This should be replaced with:
@@ -82,10 +200,18 @@ This should be replaced with:
 
 * replaces Equal(true/false) with BeTrue()/BeFalse() [Style]
 
+* negated actual value of a BeTrue or BeFalse assertion [Style]
+For example:
+	Expect(!ready).To(BeTrue())
+should be:
+	Expect(ready).To(BeFalse())
+
 * replaces HaveLen(0) with BeEmpty() [Style]
 
 * replaces Expect(...).Should(...) with Expect(...).To() [Style]
 
+* replaces Expect(...).To(Not(...)) with Expect(...).ToNot(...) [Style]
+
 * async timing interval: multiple timeout or polling interval [Style]
 For example:
 	Eventually(context.Background(), func() bool { return true }, time.Second*10).WithTimeout(time.Second * 10).WithPolling(time.Millisecond * 500).Should(BeTrue())
@@ -113,4 +239,349 @@ For example:
   Expect(err).ToNot(Succeed())
 or
   Expect(funcRetError().ToNot(HaveOccurred())
+
+* comparing a time.Duration with a raw nanoseconds literal [Style]
+For example:
+	Expect(elapsed).To(BeNumerically("<", 1000000000))
+should be:
+	Expect(elapsed).To(BeNumerically("<", time.Second))
+
+* BeNumerically("==", value) of an integer value [Style]
+For example:
+	Expect(n).To(BeNumerically("==", 5))
+should be:
+	Expect(n).To(Equal(5))
+
+* Equal assertion of a float value, that is computed by arithmetic operations [Style]
+For example:
+	Expect(a / b).To(Equal(0.5))
+should be:
+	Expect(a / b).To(BeNumerically("~", 0.5))
+
+* (optional, with the warn-float-equal flag) Equal assertion of any float value, with a float expected value [Style]
+For example:
+	Expect(x).To(Equal(3.14))
+should be:
+	Expect(x).To(BeNumerically("~", 3.14))
+
+* (optional) time.Sleep right before an assertion [Style]
+For example:
+	time.Sleep(time.Second)
+	Expect(isReady()).To(BeTrue())
+should be:
+	Eventually(isReady).WithTimeout(time.Second).Should(BeTrue())
+
+* redundant fmt.Sprintf with no formatting verbs, in the ContainSubstring or Equal matchers [Style]
+For example:
+	Expect(out).To(ContainSubstring(fmt.Sprintf("done")))
+should be:
+	Expect(out).To(ContainSubstring("done"))
+
+* redundant type conversion of the actual value or of the matcher argument [Style]
+For example, if x and y are of type int:
+	Expect(int(x)).To(Equal(int(y)))
+should be:
+	Expect(x).To(Equal(y))
+
+* wrong comparison chain assertion [Style]
+For example:
+	Expect(x == a || x == b || x == c).To(BeTrue())
+should be:
+	Expect(x).To(BeElementOf(a, b, c))
+
+* (optional) assertion of a constant slice index, with no length assertion before it [Style]
+For example:
+	Expect(s[0]).To(Equal("a"))
+should be:
+	Expect(s).ToNot(BeEmpty())
+	Expect(s[0]).To(Equal("a"))
+
+* (optional) use MatchJSON instead of Equal, to compare JSON values [Style]
+For example:
+	Expect(string(data)).To(Equal("{\"a\": 1}"))
+	Expect(raw).To(Equal(json.RawMessage("{\"a\": 1}")))
+should be:
+	Expect(data).To(MatchJSON("{\"a\": 1}"))
+	Expect(raw).To(MatchJSON("{\"a\": 1}"))
+
+* (optional) assertion of a value, when the error returned with it was discarded [Style]
+For example:
+	v, _ := strconv.Atoi(s)
+	Expect(v).To(Equal(5))
+should be:
+	v, err := strconv.Atoi(s)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(v).To(Equal(5))
+
+* (optional) not empty assertion of a slice, when its length is known from the appends before [Style]
+For example:
+	s := []int{}
+	s = append(s, 1, 2)
+	Expect(s).ToNot(BeEmpty())
+should be:
+	Expect(s).To(HaveLen(2))
+
+* (optional) synchronous assertion of a variable, that is written by a goroutine started before [Style]
+For example:
+	go func() {
+		done = true
+	}()
+	Expect(done).To(BeTrue())
+
+* (optional) assertion of the testing framework state, like t.Failed() [Style]
+For example:
+	Expect(t.Failed()).To(BeFalse())
+
+* (optional) assertion of a pointer dereference, with no not-nil assertion of the pointer before it [Style]
+For example:
+	Expect(*p).To(Equal(5))
+should be:
+	Expect(p).ToNot(BeNil())
+	Expect(*p).To(Equal(5))
+
+* (optional) enforce one assertion style: gomega-expect, for Expect(x).To(...), or gomega-omega, for Ω(x).Should(...) [Style]
+For example, with the gomega-expect style:
+	Ω(x).Should(Equal(5))
+should be:
+	Expect(x).To(Equal(5))
+
+* (optional) use Expect instead of Ω, and keep the assertion method [Style]
+For example:
+	Ω(x).Should(Equal(5))
+should be:
+	Expect(x).Should(Equal(5))
+
+* (optional) synchronous assertion of the length of a channel [Style]
+For example:
+	Expect(len(ch)).To(Equal(1))
+should be:
+	Eventually(ch).Should(Receive())
+
+* (optional) spec closure, like It, that uses a loop variable, before go 1.22 [Style]
+For example:
+	for _, tc := range cases {
+		It(tc.name, func() {
+			Expect(tc.got).To(Equal(tc.exp))
+		})
+	}
+
+* (optional) assertion result that is assigned to a variable, or used as a condition [Style]
+For example:
+	ok := Expect(x).To(Equal(y))
+should be:
+	Expect(x).To(Equal(y))
+
+* (optional) Succeed() assertion of a function call, when the error of the same call is already stored and checked [Style]
+For example:
+	err := f()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(f()).To(Succeed())
+
+* (optional) assertion of the recover() result in a deferred function, instead of the Panic or PanicWith matchers [Style]
+For example:
+	defer func() {
+		Expect(recover()).To(Equal("boom"))
+	}()
+	mayPanic()
+should be:
+	Expect(mayPanic).To(PanicWith("boom"))
+
+* (optional) gomega assertion that is the call of a defer statement [Bug]
+For example:
+	defer Expect(x).To(Equal(1))
+should be:
+	DeferCleanup(func() { Expect(x).To(Equal(1)) })
+
+* (optional) assertion of a method expression, that is not bound to any receiver [Style]
+For example:
+	Expect(Counter.Value).ToNot(BeNil())
+should be:
+	Expect(c.Value()).To(Equal(1))
+
+* (optional) equality assertion of utf8.RuneCountInString, that may be confused with the length in bytes [Style]
+For example:
+	Expect(utf8.RuneCountInString(s)).To(Equal(5))
+If the length in bytes is meant, should be:
+	Expect(s).To(HaveLen(5))
+
+* (optional) Equal assertion of a slice with a slice literal, instead of HaveExactElements [Style]
+For example:
+	Expect(s).To(Equal([]int{1, 2, 3}))
+should be:
+	Expect(s).To(HaveExactElements(1, 2, 3))
+
+* (optional) Eventually or Consistently with a function, that only runs a blocking operation [Style]
+For example:
+	Consistently(func() int { return <-ch }).Should(Equal(1))
+
+* (optional) Consistently with a function, that receives from a channel, and drains it on each polling [Bug]
+For example:
+	Consistently(func() int { v := <-ch; return v }).Should(BeNumerically(">", 0))
+
+* (optional) Equal assertion of the Bytes() of a bytes.Buffer, instead of its String() [Style]
+For example:
+	Expect(buf.Bytes()).To(Equal([]byte("x")))
+should be:
+	Expect(buf.String()).To(Equal("x"))
+
+* (optional) Equal assertion of a struct with a pointer field, that compares the pointed values [Style]
+For example:
+	Expect(structWithPtr).To(Equal(otherStructWithPtr))
+
+* (optional) Equal assertion of a protobuf message [Bug]
+For example:
+	Expect(msg).To(Equal(expected))
+should be:
+	Expect(msg).To(BeComparableTo(expected, protocmp.Transform()))
+
+* (optional) BeNil() or HaveOccurred() assertion of an interface, that is assigned with a concrete pointer value [Style]
+For example:
+	var p *MyErr
+	var err error = p
+	Expect(err).ToNot(HaveOccurred())
+
+* (optional) two assertions of the same variable, with complementary BeNumerically bounds of the same value [Style]
+For example:
+	Expect(n).To(BeNumerically(">=", 5))
+	Expect(n).To(BeNumerically("<=", 5))
+should be:
+	Expect(n).To(Equal(5))
+
+* (optional) Equal assertion with an expected sql.Null* literal, that does not set the Valid field [Bug]
+For example:
+	Expect(name).To(Equal(sql.NullString{String: "x"}))
+should be:
+	Expect(name).To(Equal(sql.NullString{String: "x", Valid: true}))
+
+* (optional) HaveKey assertion of a map, followed by an Equal assertion of the same key [Style]
+For example:
+	Expect(m).To(HaveKey("a"))
+	Expect(m["a"]).To(Equal(1))
+should be:
+	Expect(m).To(HaveKeyWithValue("a", 1))
+
+* (optional) ContainElement assertion with a nested Equal matcher of a struct literal [Style]
+For example:
+	Expect(users).To(ContainElement(Equal(User{Name: "a", Age: 3})))
+consider using:
+	Expect(users).To(ContainElement(HaveField("Name", "a")))
+
+* (optional) Eventually or Consistently function literal, that discards an error [Bug]
+For example:
+	Eventually(func() int { v, _ := getValue(); return v }).Should(Equal(1))
+should be:
+	Eventually(func(g Gomega) int {
+		v, err := getValue()
+		g.Expect(err).ToNot(HaveOccurred())
+		return v
+	}).Should(Equal(1))
+
+* Eventually or Consistently function literal, that runs gomega assertions without a Gomega argument [Bug]
+For example:
+	Eventually(func() bool { Expect(x).To(Equal(5)); return true }).Should(BeTrue())
+should be:
+	Eventually(func(g Gomega) { g.Expect(x).To(Equal(5)) }).Should(Succeed())
+
+* (optional) assertion of a function call that returns a value and an error, with a value matcher [Style]
+For example:
+	Expect(strconv.Atoi(s)).To(Equal(5))
+should be:
+	n, err := strconv.Atoi(s)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(n).To(Equal(5))
+
+* assertion of the value and the boolean results of a sync.Map method, like Load [Bug]
+For example:
+	Expect(m.Load(key)).To(Equal(value))
+should be:
+	v, ok := m.Load(key)
+	Expect(ok).To(BeTrue())
+	Expect(v).To(Equal(value))
+
+* (optional) assertion of the Done() channel of a context, with a matcher other than BeClosed [Bug]
+For example:
+	Eventually(ctx.Done()).Should(Receive())
+should be:
+	Eventually(ctx.Done()).Should(BeClosed())
+
+* (optional) Equal assertion of an array, that is longer than the large-array-len flag value [Style]
+For example:
+	var a, b [4096]byte
+	Expect(a).To(Equal(b))
+should be:
+	Expect(a[:]).To(Equal(b[:]))
+
+* (optional) Equal or ConsistOf assertion of a slice of errors [Bug]
+For example:
+	Expect(errs).To(Equal([]error{ErrNotFound}))
+should be:
+	Expect(errs).To(ContainElement(MatchError(ErrNotFound)))
+
+* (optional) Equal assertion of a struct with a time.Time field [Bug]
+For example:
+	Expect(got).To(Equal(want))
+should be:
+	Expect(got).To(BeComparableTo(want))
+
+* (optional) Equal or BeNumerically assertion of a float actual value, with a non-constant integer expected value [Bug]
+For example:
+	Expect(obj["id"].(float64)).To(BeNumerically("==", id))
+
+* (optional) string matcher assertion of the Error() string of an error [Style]
+For example:
+	Expect(err.Error()).To(ContainSubstring("not found"))
+should be:
+	Expect(err).To(MatchError(ContainSubstring("not found")))
+
+* (optional) Equal assertion of a slice, that is built by a range loop over a map [Bug]
+For example:
+	for k := range m {
+		keys = append(keys, k)
+	}
+	Expect(keys).To(Equal(want))
+should be:
+	Expect(keys).To(ConsistOf(want))
+
+* (optional) assertions of the results of os.Stat, instead of using the file system matchers [Style]
+For example:
+	info, err := os.Stat(path)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(info.IsDir()).To(BeTrue())
+should be:
+	Expect(path).To(BeADirectory())
+
+* (optional) Equal assertions of strconv string conversions, instead of asserting the numeric value [Style]
+For example:
+	Expect(strconv.Itoa(n)).To(Equal("5"))
+should be:
+	Expect(n).To(Equal(5))
+
+* (optional) consecutive assertions of the same actual value [Style]
+For example:
+	Expect(x).To(BeNumerically(">", 0))
+	Expect(x).To(BeNumerically("<", 10))
+should be:
+	Expect(x).To(SatisfyAll(BeNumerically(">", 0), BeNumerically("<", 10)))
+
+* (optional) a matcher in a variable, that is used by more than one assertion [Bug]
+For example:
+	m := Receive(&x)
+	Expect(ch1).To(m)
+	Expect(ch2).To(m) // create a new matcher for each assertion
+
+* (optional) assertion of a value, that is transformed by a package level function [Style]
+For example:
+	Expect(strings.ToLower(s)).To(Equal("abc"))
+should be:
+	Expect(s).To(WithTransform(strings.ToLower, Equal("abc")))
+
+* (optional) actual functions with offset, like ExpectWithOffset [Style]
+For example:
+	ExpectWithOffset(1, x).To(Equal(5))
+should be:
+	Expect(x).WithOffset(1).To(Equal(5))
+
+Custom wrapper matchers:
+Use the --matcher-aliases flag to map custom wrapper matchers to the gomega matchers they wrap, to apply the same
+rules on the wrappers; e.g. --matcher-aliases=MyEqual=Equal,BeEmptyList=BeEmpty
 `
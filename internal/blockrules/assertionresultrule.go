@@ -0,0 +1,72 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+)
+
+const assertionResultTemplate = "the result of the %s assertion is not needed; gomega already fails the test if the assertion fails; use the assertion as a statement instead"
+
+// AssertionResultRule finds assertions with a boolean result that is assigned to a variable, or
+// that is used as a condition; e.g.
+//
+//	ok := Expect(x).To(Equal(y))
+//	if Expect(x).To(Equal(y)) {
+//
+// should be:
+//
+//	Expect(x).To(Equal(y))
+//
+// Gomega already fails the test when the assertion fails, so the result is true, whenever the code
+// after the assertion runs.
+type AssertionResultRule struct{}
+
+func (r AssertionResultRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for _, stmt := range stmts {
+		if !ctx.ConfigFor(stmt).ForbidAssertionResult {
+			continue
+		}
+
+		for _, expr := range r.getResultExprs(stmt) {
+			gexp, ok := ctx.GetAssertionExpr(expr)
+			if !ok || !r.isBool(expr, ctx) {
+				continue
+			}
+
+			ctx.Report(expr, assertionResultTemplate, gexp.GetOrigAssertFuncName())
+		}
+	}
+}
+
+// getResultExprs returns the expressions, which their result is used by the statement; i.e. the
+// assigned values, and the condition of an if statement
+func (AssertionResultRule) getResultExprs(stmt ast.Stmt) []ast.Expr {
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		return s.Rhs
+
+	case *ast.DeclStmt:
+		genDecl, ok := s.Decl.(*ast.GenDecl)
+		if !ok {
+			return nil
+		}
+
+		var exprs []ast.Expr
+		for _, spec := range genDecl.Specs {
+			if valueSpec, ok := spec.(*ast.ValueSpec); ok {
+				exprs = append(exprs, valueSpec.Values...)
+			}
+		}
+		return exprs
+
+	case *ast.IfStmt:
+		return []ast.Expr{s.Cond}
+	}
+
+	return nil
+}
+
+func (AssertionResultRule) isBool(expr ast.Expr, ctx *Context) bool {
+	basic, ok := ctx.Pass().TypesInfo.TypeOf(expr).(*gotypes.Basic)
+	return ok && basic.Kind() == gotypes.Bool
+}
@@ -0,0 +1,78 @@
+package blockrules
+
+import (
+	"go/ast"
+
+	"github.com/nunnatsa/ginkgolinter/internal/gomegainfo"
+)
+
+const asyncGomegaParamTemplate = "the function of %s runs a gomega assertion, but it does not receive a Gomega argument; a failure of this assertion fails the test immediately, instead of polling again; add a `g Gomega` parameter to the function, and use `g.Expect`"
+
+// AsyncGomegaParamRule finds an async assertion of a function literal, that runs gomega
+// assertions, but does not receive a Gomega argument; e.g.
+//
+//	Eventually(func() {
+//		Expect(x).To(Equal(5))
+//	}).Should(Succeed())
+//
+// should be:
+//
+//	Eventually(func(g Gomega) {
+//		g.Expect(x).To(Equal(5))
+//	}).Should(Succeed())
+//
+// Without the Gomega argument, a failed inner assertion fails the test at the first polling.
+// Assertions in nested function literals are ignored, because they may not run by the function.
+type AsyncGomegaParamRule struct{}
+
+func (r AsyncGomegaParamRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for _, stmt := range stmts {
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || !gexp.IsAsync() || ctx.ConfigFor(stmt).SuppressAsync {
+			continue
+		}
+
+		fn, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.FuncLit)
+		if !ok || r.takesGomega(fn, ctx) {
+			continue
+		}
+
+		if inner := r.findAssertion(fn.Body, ctx); inner != nil {
+			ctx.Report(inner, asyncGomegaParamTemplate, gexp.GetActualFuncName())
+		}
+	}
+}
+
+func (AsyncGomegaParamRule) takesGomega(fn *ast.FuncLit, ctx *Context) bool {
+	for _, field := range fn.Type.Params.List {
+		if t := ctx.Pass().TypesInfo.TypeOf(field.Type); t != nil && gomegainfo.IsGomegaType(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findAssertion returns the first gomega assertion in the function body
+func (AsyncGomegaParamRule) findAssertion(body *ast.BlockStmt, ctx *Context) ast.Expr {
+	var found ast.Expr
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if _, ok := ctx.GetAssertionExpr(node); ok {
+				found = node
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
@@ -0,0 +1,172 @@
+package blockrules
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/formatter"
+	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+// BlockRule checks a list of statements of the same block, to find issues that can't be found
+// by looking at a single gomega expression; e.g. a time.Sleep call, right before an assertion.
+//
+// A block rule reads its configuration from the assertion statement it checks, even if the issue
+// is reported on a previous statement, so the suppression comments are always placed on the
+// assertion line.
+type BlockRule interface {
+	Apply(stmts []ast.Stmt, ctx *Context)
+}
+
+var blockRules = []BlockRule{
+	&SleepBeforeAssertionRule{},
+	&UnguardedIndexRule{},
+	&DiscardedErrorRule{},
+	&KnownLengthRule{},
+	&GoroutineVarRule{},
+	&UnguardedDerefRule{},
+	&AssertionResultRule{},
+	&RedundantSucceedRule{},
+	&DeferRecoverRule{},
+	&TypedNilRule{},
+	&DeferredAssertionRule{},
+	&NumericBoundsRule{},
+	&HaveKeyWithValueRule{},
+	&RegexpMatchRule{},
+	&MapOrderEqualRule{},
+	&FileStatRule{},
+	&SatisfyAllRule{},
+	&SharedMatcherRule{},
+	&AsyncGomegaParamRule{},
+}
+
+// Apply runs all the block rules on the statements of a block, a case clause or a select clause.
+// Other nodes are ignored.
+func Apply(node ast.Node, ctx *Context) {
+	var stmts []ast.Stmt
+	switch n := node.(type) {
+	case *ast.BlockStmt:
+		stmts = n.List
+	case *ast.CaseClause:
+		stmts = n.Body
+	case *ast.CommClause:
+		stmts = n.Body
+	default:
+		return
+	}
+
+	if len(stmts) == 0 {
+		return
+	}
+
+	for _, rule := range blockRules {
+		rule.Apply(stmts, ctx)
+	}
+}
+
+// Context holds the file level information, the block rules need to check the statements
+type Context struct {
+	pass    *analysis.Pass
+	handler gomegahandler.Handler
+	config  types.Config
+	cm      ast.CommentMap
+	timePkg string
+}
+
+func NewContext(pass *analysis.Pass, handler gomegahandler.Handler, config types.Config, cm ast.CommentMap, timePkg string) *Context {
+	return &Context{
+		pass:    pass,
+		handler: handler,
+		config:  config,
+		cm:      cm,
+		timePkg: timePkg,
+	}
+}
+
+func (c *Context) Pass() *analysis.Pass {
+	return c.pass
+}
+
+// ConfigFor returns the file configuration, updated by the comments of the statement. The block
+// rules call it with the assertion statement.
+func (c *Context) ConfigFor(stmt ast.Stmt) types.Config {
+	config := c.config.Clone()
+	if comments, ok := c.cm[stmt]; ok {
+		config.UpdateFromComment(comments)
+	}
+
+	return config
+}
+
+// GetAssertion returns the gomega expression of the statement, if the statement is a complete
+// gomega assertion, like `Expect(x).To(Equal(y))`
+func (c *Context) GetAssertion(stmt ast.Stmt) (*expression.GomegaExpression, bool) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, false
+	}
+
+	return c.GetAssertionExpr(exprStmt.X)
+}
+
+// GetAssertionExpr returns the gomega expression, if the expression is a complete gomega
+// assertion, like `Expect(x).To(Equal(y))`
+func (c *Context) GetAssertionExpr(expr ast.Expr) (*expression.GomegaExpression, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	gexp, ok := expression.New(call, c.pass, c.handler, c.timePkg, c.config.MatcherAliases)
+	if !ok || gexp == nil || gexp.IsMissingAssertion() {
+		return nil, false
+	}
+
+	return gexp, true
+}
+
+// FormatExpr returns the source code of the expression
+func (c *Context) FormatExpr(expr ast.Expr) string {
+	return formatter.NewGoFmtFormatter(c.pass.Fset).Format(expr)
+}
+
+// Report reports an issue with no suggested fix, at the position of the expression
+func (c *Context) Report(expr ast.Expr, template string, args ...any) {
+	reportBuilder := reports.NewBuilder(expr, formatter.NewGoFmtFormatter(c.pass.Fset))
+	reportBuilder.AddIssue(false, template, args...)
+	c.pass.Report(reportBuilder.Build())
+}
+
+// ReportWithFix reports an issue at the position of the assertion statement, and suggests the
+// fixed assertion
+func (c *Context) ReportWithFix(stmt ast.Stmt, fix ast.Expr, template string, args ...any) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return
+	}
+
+	reportBuilder := reports.NewBuilder(exprStmt.X, formatter.NewGoFmtFormatter(c.pass.Fset))
+	reportBuilder.AddIssue(true, template, args...)
+	reportBuilder.SetFixOffer(fix)
+	c.pass.Report(reportBuilder.Build())
+}
+
+// ReportMergeWithFix reports an issue at the position of the first assertion statement, and
+// suggests replacing both the first and the last statements, and the code between them, with the
+// fixed assertion
+func (c *Context) ReportMergeWithFix(first, last ast.Stmt, fix ast.Expr, template string, args ...any) {
+	exprStmt, ok := first.(*ast.ExprStmt)
+	if !ok {
+		return
+	}
+
+	reportBuilder := reports.NewBuilder(exprStmt.X, formatter.NewGoFmtFormatter(c.pass.Fset))
+	reportBuilder.AddIssue(true, template, args...)
+	reportBuilder.SetFixOffer(fix)
+	reportBuilder.SetFixEnd(last.End())
+	c.pass.Report(reportBuilder.Build())
+}
@@ -0,0 +1,104 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+)
+
+const deferRecoverTemplate = "asserting the result of recover() in a deferred function; use the Panic or the PanicWith matchers instead; e.g. `Expect(func() { mayPanic() }).To(PanicWith(value))`"
+
+// DeferRecoverRule finds assertions of the recover() result, in a deferred function literal, that
+// are used to check that the code after the defer statement panics; e.g.
+//
+//	defer func() {
+//		r := recover()
+//		Expect(r).To(Equal("boom"))
+//	}()
+//	mayPanic()
+//
+// should be:
+//
+//	Expect(mayPanic).To(PanicWith("boom"))
+//
+// The deferred assertion also passes if the code does not panic at all, when the matcher accepts
+// nil, and it hides any other panic in the rest of the function.
+type DeferRecoverRule struct{}
+
+func (r DeferRecoverRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for _, stmt := range stmts {
+		deferStmt, ok := stmt.(*ast.DeferStmt)
+		if !ok {
+			continue
+		}
+
+		funcLit, ok := deferStmt.Call.Fun.(*ast.FuncLit)
+		if !ok || funcLit.Body == nil {
+			continue
+		}
+
+		r.checkDeferredFunc(funcLit.Body.List, map[gotypes.Object]bool{}, ctx)
+	}
+}
+
+// checkDeferredFunc reports the assertions of the recover() result, in the body of the deferred
+// function; i.e. `Expect(recover())`, or an assertion of a variable that is assigned with
+// recover(), like `r := recover()`. The body of an if statement is also checked, for the
+// `if r := recover(); r != nil {` idiom.
+func (r DeferRecoverRule) checkDeferredFunc(stmts []ast.Stmt, recovered map[gotypes.Object]bool, ctx *Context) {
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			r.addRecovered(s, recovered, ctx)
+			continue
+
+		case *ast.IfStmt:
+			if assign, ok := s.Init.(*ast.AssignStmt); ok {
+				r.addRecovered(assign, recovered, ctx)
+			}
+			r.checkDeferredFunc(s.Body.List, recovered, ctx)
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || gexp.IsAsync() || !ctx.ConfigFor(stmt).ForbidDeferRecover {
+			continue
+		}
+
+		actualArg := ast.Unparen(gexp.GetOrigActualArgExpr())
+		if !r.isRecoverCall(actualArg, ctx) {
+			ident, ok := actualArg.(*ast.Ident)
+			if !ok || !recovered[ctx.Pass().TypesInfo.ObjectOf(ident)] {
+				continue
+			}
+		}
+
+		ctx.Report(actualArg, deferRecoverTemplate)
+	}
+}
+
+// addRecovered records the variable that is assigned with the recover() result
+func (r DeferRecoverRule) addRecovered(assign *ast.AssignStmt, recovered map[gotypes.Object]bool, ctx *Context) {
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || !r.isRecoverCall(assign.Rhs[0], ctx) {
+		return
+	}
+
+	if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+		recovered[ctx.Pass().TypesInfo.ObjectOf(ident)] = true
+	}
+}
+
+// isRecoverCall checks if the expression is a call to the recover builtin function
+func (DeferRecoverRule) isRecoverCall(expr ast.Expr, ctx *Context) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || fun.Name != "recover" {
+		return false
+	}
+
+	_, ok = ctx.Pass().TypesInfo.ObjectOf(fun).(*gotypes.Builtin)
+	return ok
+}
@@ -0,0 +1,36 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+)
+
+const deferredAssertionTemplate = "deferred assertion; the actual value and the matcher are evaluated at the defer statement, and only the assertion itself runs at the end of the function; use DeferCleanup, or assert inline; e.g. `DeferCleanup(func() { %s })`"
+
+// DeferredAssertionRule finds gomega assertions that are the call of a defer statement; e.g.
+//
+//	defer Expect(x).To(Equal(1))
+//
+// The arguments of a deferred call are evaluated at the defer statement, so the assertion checks
+// the value of x before the rest of the function runs, and not at its end. In ginkgo, the
+// assertion should be registered with DeferCleanup, or checked inline:
+//
+//	DeferCleanup(func() {
+//		Expect(x).To(Equal(1))
+//	})
+type DeferredAssertionRule struct{}
+
+func (DeferredAssertionRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for _, stmt := range stmts {
+		deferStmt, ok := stmt.(*ast.DeferStmt)
+		if !ok {
+			continue
+		}
+
+		if _, ok := ctx.GetAssertionExpr(deferStmt.Call); !ok || !ctx.ConfigFor(stmt).ForbidDeferredAssertion {
+			continue
+		}
+
+		ctx.Report(deferStmt.Call, deferredAssertionTemplate, gotypes.ExprString(deferStmt.Call))
+	}
+}
@@ -0,0 +1,102 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+)
+
+const discardedErrorTemplate = "asserting %[1]s, while the error returned with it by %[2]s is discarded; assert the error too; e.g. `Expect(err).ToNot(HaveOccurred())`"
+
+var errorType = gotypes.Universe.Lookup("error").Type().Underlying().(*gotypes.Interface)
+
+// DiscardedErrorRule finds an assertion of a value, when the error returned with the value, in the
+// immediately preceding statement, was discarded by assigning it to `_`; e.g.
+//
+//	v, _ := strconv.Atoi(s)
+//	Expect(v).To(Equal(5))
+//
+// should be:
+//
+//	v, err := strconv.Atoi(s)
+//	Expect(err).ToNot(HaveOccurred())
+//	Expect(v).To(Equal(5))
+type DiscardedErrorRule struct{}
+
+func (r DiscardedErrorRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for i := 0; i < len(stmts)-1; i++ {
+		call, values, ok := r.getDiscardedErrorAssignment(stmts[i], ctx)
+		if !ok || !ctx.ConfigFor(stmts[i+1]).ForbidDiscardedError {
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmts[i+1])
+		if !ok {
+			continue
+		}
+
+		actualArg := gexp.GetOrigActualArgExpr()
+		if name, found := r.findValue(actualArg, values, ctx); found {
+			ctx.Report(actualArg, discardedErrorTemplate, name, gotypes.ExprString(call.Fun))
+		}
+	}
+}
+
+// getDiscardedErrorAssignment checks if the statement is an assignment of the results of a
+// function call, that discards the returned error; e.g. `v, _ := f()`. It returns the function
+// call, and the objects of the other assigned variables.
+func (DiscardedErrorRule) getDiscardedErrorAssignment(stmt ast.Stmt, ctx *Context) (*ast.CallExpr, map[gotypes.Object]bool, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 || len(assign.Lhs) < 2 || (assign.Tok != token.DEFINE && assign.Tok != token.ASSIGN) {
+		return nil, nil, false
+	}
+
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok {
+		return nil, nil, false
+	}
+
+	results, ok := ctx.Pass().TypesInfo.TypeOf(call).(*gotypes.Tuple)
+	if !ok || results.Len() != len(assign.Lhs) {
+		return nil, nil, false
+	}
+
+	discarded := false
+	values := map[gotypes.Object]bool{}
+	for i, lhs := range assign.Lhs {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if id.Name == "_" {
+			if gotypes.Implements(results.At(i).Type(), errorType) {
+				discarded = true
+			}
+			continue
+		}
+
+		if obj := ctx.Pass().TypesInfo.ObjectOf(id); obj != nil {
+			values[obj] = true
+		}
+	}
+
+	if !discarded || len(values) == 0 {
+		return nil, nil, false
+	}
+
+	return call, values, true
+}
+
+// findValue looks for a use of one of the assigned variables, in the actual value
+func (DiscardedErrorRule) findValue(actualArg ast.Expr, values map[gotypes.Object]bool, ctx *Context) (string, bool) {
+	name := ""
+	ast.Inspect(actualArg, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && values[ctx.Pass().TypesInfo.Uses[id]] {
+			name = id.Name
+		}
+		return name == ""
+	})
+
+	return name, name != ""
+}
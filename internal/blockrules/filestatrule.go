@@ -0,0 +1,163 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+const fileStatTemplate = "asserting %[1]s, the result of os.Stat(%[2]s); use the %[3]s matcher instead, for a better failure message; e.g. `Expect(%[2]s).To(%[3]s())`"
+
+// FileStatRule finds assertions of the results of os.Stat, that was called in the same block, that
+// can be replaced by the gomega file system matchers; e.g.
+//
+//	info, err := os.Stat(path)
+//	Expect(err).ToNot(HaveOccurred())
+//	Expect(info.IsDir()).To(BeTrue())
+//
+// should be:
+//
+//	Expect(path).To(BeADirectory())
+//
+// A nil error assertion is replaced by BeAnExistingFile, `info.IsDir()` by BeADirectory, and
+// `info.Mode().IsRegular()` by BeARegularFile. The code may use the results for other purposes, so
+// the issue is reported with no suggested fix.
+type FileStatRule struct{}
+
+func (r FileStatRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	stats := map[gotypes.Object]ast.Expr{}
+	errs := map[gotypes.Object]ast.Expr{}
+
+	for _, stmt := range stmts {
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			r.updateFromAssignment(assign, stats, errs, ctx)
+			continue
+		}
+
+		if len(stats) == 0 && len(errs) == 0 {
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || gexp.IsAsync() || gexp.HasAliasedMatcher() || !ctx.ConfigFor(stmt).ForceFileMatchers {
+			continue
+		}
+
+		actualArg := ast.Unparen(gexp.GetOrigActualArgExpr())
+
+		if ident, ok := actualArg.(*ast.Ident); ok {
+			if path, ok := errs[ctx.Pass().TypesInfo.ObjectOf(ident)]; ok && isNilErrorAssertion(gexp) {
+				ctx.Report(ident, fileStatTemplate, ident.Name, ctx.FormatExpr(path), "BeAnExistingFile")
+			}
+			continue
+		}
+
+		call, ok := actualArg.(*ast.CallExpr)
+		if !ok || !isTrueAssertion(gexp) {
+			continue
+		}
+
+		if path, matcherName, ok := r.getFileModeCheck(call, stats, ctx); ok {
+			ctx.Report(call, fileStatTemplate, ctx.FormatExpr(call), ctx.FormatExpr(path), matcherName)
+		}
+	}
+}
+
+// updateFromAssignment keeps the file info and the error variables that are assigned with the
+// results of os.Stat, and forgets the variables that are assigned with any other value
+func (FileStatRule) updateFromAssignment(assign *ast.AssignStmt, stats, errs map[gotypes.Object]ast.Expr, ctx *Context) {
+	for _, lhs := range assign.Lhs {
+		if ident, ok := lhs.(*ast.Ident); ok {
+			obj := ctx.Pass().TypesInfo.ObjectOf(ident)
+			delete(stats, obj)
+			delete(errs, obj)
+		}
+	}
+
+	if len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return
+	}
+
+	call, ok := ast.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !funccall.IsPkgFunc(ctx.Pass(), call, "os", "Stat") {
+		return
+	}
+
+	path := call.Args[0]
+	if ident, ok := assign.Lhs[0].(*ast.Ident); ok && ident.Name != "_" {
+		if obj := ctx.Pass().TypesInfo.ObjectOf(ident); obj != nil {
+			stats[obj] = path
+		}
+	}
+
+	if ident, ok := assign.Lhs[1].(*ast.Ident); ok && ident.Name != "_" {
+		if obj := ctx.Pass().TypesInfo.ObjectOf(ident); obj != nil {
+			errs[obj] = path
+		}
+	}
+}
+
+// getFileModeCheck returns the path and the matching file system matcher, if the call is
+// `info.IsDir()` or `info.Mode().IsRegular()`, of a file info returned by os.Stat
+func (FileStatRule) getFileModeCheck(call *ast.CallExpr, stats map[gotypes.Object]ast.Expr, ctx *Context) (ast.Expr, string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil, "", false
+	}
+
+	var (
+		receiver    ast.Expr
+		matcherName string
+	)
+
+	switch sel.Sel.Name {
+	case "IsDir":
+		receiver = sel.X
+		matcherName = "BeADirectory"
+	case "IsRegular":
+		modeCall, ok := ast.Unparen(sel.X).(*ast.CallExpr)
+		if !ok || len(modeCall.Args) != 0 {
+			return nil, "", false
+		}
+
+		modeSel, ok := modeCall.Fun.(*ast.SelectorExpr)
+		if !ok || modeSel.Sel.Name != "Mode" {
+			return nil, "", false
+		}
+		receiver = modeSel.X
+		matcherName = "BeARegularFile"
+	default:
+		return nil, "", false
+	}
+
+	ident, ok := ast.Unparen(receiver).(*ast.Ident)
+	if !ok {
+		return nil, "", false
+	}
+
+	path, ok := stats[ctx.Pass().TypesInfo.ObjectOf(ident)]
+	return path, matcherName, ok
+}
+
+// isNilErrorAssertion checks if the assertion verifies that its actual value is a nil error; i.e.
+// `ToNot(HaveOccurred())`, `To(BeNil())` or `To(Succeed())`
+func isNilErrorAssertion(gexp *expression.GomegaExpression) bool {
+	if gexp.IsNegativeAssertion() {
+		return gexp.MatcherTypeIs(matcher.HaveOccurredMatcherType)
+	}
+
+	return gexp.MatcherTypeIs(matcher.BeNilMatcherType | matcher.SucceedMatcherType)
+}
+
+// isTrueAssertion checks if the assertion verifies that its boolean actual value is true; i.e.
+// `To(BeTrue())` or `ToNot(BeFalse())`
+func isTrueAssertion(gexp *expression.GomegaExpression) bool {
+	if gexp.IsNegativeAssertion() {
+		return gexp.MatcherTypeIs(matcher.BoolValueFalse)
+	}
+
+	return gexp.MatcherTypeIs(matcher.BoolValueTrue)
+}
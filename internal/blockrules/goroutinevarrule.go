@@ -0,0 +1,122 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+const goroutineVarTemplate = "asserting %s, that is written by a goroutine started before, with no synchronization; this is a data race; use Eventually with a synchronized getter instead; e.g. a getter that locks a mutex"
+
+// GoroutineVarRule finds synchronous assertions of a variable, that is written by a goroutine that
+// was started before, in the same block; e.g.
+//
+//	done := false
+//	go func() {
+//		done = true
+//	}()
+//	Expect(done).To(BeTrue())
+//
+// A `Wait()` call of a sync.WaitGroup, or a channel receive, between the go statement and the
+// assertion, is considered as synchronization, and the variable is not reported after it.
+type GoroutineVarRule struct{}
+
+func (r GoroutineVarRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	written := map[gotypes.Object]bool{}
+
+	for _, stmt := range stmts {
+		if goStmt, ok := stmt.(*ast.GoStmt); ok {
+			r.addWrittenVars(goStmt, written, ctx)
+			continue
+		}
+
+		if len(written) == 0 {
+			continue
+		}
+
+		if r.isSync(stmt, ctx) {
+			clear(written)
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || gexp.IsAsync() || !ctx.ConfigFor(stmt).ForbidGoroutineSharedVar {
+			continue
+		}
+
+		ast.Inspect(gexp.GetOrigActualArgExpr(), func(n ast.Node) bool {
+			ident, ok := n.(*ast.Ident)
+			if !ok || !written[ctx.Pass().TypesInfo.ObjectOf(ident)] {
+				return true
+			}
+
+			ctx.Report(ident, goroutineVarTemplate, ident.Name)
+			return false
+		})
+	}
+}
+
+// addWrittenVars adds the variables that are declared out of the goroutine function, and are
+// assigned inside it
+func (GoroutineVarRule) addWrittenVars(goStmt *ast.GoStmt, written map[gotypes.Object]bool, ctx *Context) {
+	funcLit, ok := goStmt.Call.Fun.(*ast.FuncLit)
+	if !ok {
+		return
+	}
+
+	addVar := func(expr ast.Expr) {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		obj, ok := ctx.Pass().TypesInfo.ObjectOf(ident).(*gotypes.Var)
+		if !ok || (obj.Pos() >= funcLit.Pos() && obj.Pos() < funcLit.End()) {
+			return
+		}
+
+		written[obj] = true
+	}
+
+	ast.Inspect(funcLit.Body, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.AssignStmt:
+			if s.Tok != token.DEFINE {
+				for _, lhs := range s.Lhs {
+					addVar(lhs)
+				}
+			}
+		case *ast.IncDecStmt:
+			addVar(s.X)
+		}
+		return true
+	})
+}
+
+// isSync checks if the statement waits for the goroutines; i.e. a `Wait()` call of a
+// sync.WaitGroup, or a channel receive
+func (GoroutineVarRule) isSync(stmt ast.Stmt, ctx *Context) bool {
+	var expr ast.Expr
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		expr = s.X
+	case *ast.AssignStmt:
+		if len(s.Rhs) != 1 {
+			return false
+		}
+		expr = s.Rhs[0]
+	default:
+		return false
+	}
+
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.UnaryExpr:
+		return e.Op == token.ARROW
+	case *ast.CallExpr:
+		return funccall.IsMethodOf(ctx.Pass(), e, "sync", "WaitGroup", "Wait")
+	}
+
+	return false
+}
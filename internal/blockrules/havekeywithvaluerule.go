@@ -0,0 +1,86 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+)
+
+const haveKeyWithValueTemplate = "the HaveKey assertion of %[1]s, followed by the Equal assertion of %[1]s[%[2]s], can be merged into a single HaveKeyWithValue assertion"
+
+// HaveKeyWithValueRule finds a HaveKey assertion of a map, that is followed by an Equal assertion
+// of the value of the same key, in the next statement; e.g.
+//
+//	Expect(m).To(HaveKey("a"))
+//	Expect(m["a"]).To(Equal(1))
+//
+// should be:
+//
+//	Expect(m).To(HaveKeyWithValue("a", 1))
+//
+// The map and the key must be variables, fields or literals, so they are known to be the same in
+// both assertions.
+type HaveKeyWithValueRule struct{}
+
+func (r HaveKeyWithValueRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for i := 0; i < len(stmts)-1; i++ {
+		haveKey, ok := ctx.GetAssertion(stmts[i])
+		if !ok || !r.isPositiveSync(haveKey, "HaveKey") || !ctx.ConfigFor(stmts[i]).ForceHaveKeyWithValue {
+			continue
+		}
+
+		equal, ok := ctx.GetAssertion(stmts[i+1])
+		if !ok || !r.isPositiveSync(equal, "Equal") {
+			continue
+		}
+
+		mapExpr := ast.Unparen(haveKey.GetOrigActualArgExpr())
+		keyExpr := ast.Unparen(haveKey.GetMatcher().Orig.Args[0])
+		if !isStableExpr(mapExpr) || !isStableExpr(keyExpr) {
+			continue
+		}
+
+		index, ok := ast.Unparen(equal.GetOrigActualArgExpr()).(*ast.IndexExpr)
+		if !ok ||
+			gotypes.ExprString(ast.Unparen(index.X)) != gotypes.ExprString(mapExpr) ||
+			gotypes.ExprString(ast.Unparen(index.Index)) != gotypes.ExprString(keyExpr) {
+			continue
+		}
+
+		if _, ok := ctx.Pass().TypesInfo.TypeOf(index.X).Underlying().(*gotypes.Map); !ok {
+			continue
+		}
+
+		// the value is moved from the next line, so it is added as a formatted text, to keep the
+		// fixed assertion in one line
+		value := ast.NewIdent(ctx.FormatExpr(equal.GetMatcher().Orig.Args[0]))
+		haveKey.ReplaceMatcherFuncName("HaveKeyWithValue")
+		haveKey.ReplaceMatcherArgs([]ast.Expr{haveKey.GetMatcher().Clone.Args[0], value})
+		ctx.ReportMergeWithFix(stmts[i], stmts[i+1], haveKey.GetClone(), haveKeyWithValueTemplate, gotypes.ExprString(mapExpr), gotypes.ExprString(keyExpr))
+		i++
+	}
+}
+
+// isPositiveSync checks if the assertion is a positive, synchronous assertion, with the given
+// gomega matcher, with a single argument
+func (HaveKeyWithValueRule) isPositiveSync(gexp *expression.GomegaExpression, matcherName string) bool {
+	if gexp.IsAsync() || gexp.IsNegativeAssertion() || gexp.HasAliasedMatcher() {
+		return false
+	}
+
+	return gexp.GetMatcherInfo().MatcherName() == matcherName && len(gexp.GetMatcher().Orig.Args) == 1
+}
+
+// isStableExpr checks if the expression is a variable, a field or a literal, that returns the same
+// value every time it is evaluated
+func isStableExpr(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident, *ast.BasicLit:
+		return true
+	case *ast.SelectorExpr:
+		return isStableExpr(e.X)
+	}
+
+	return false
+}
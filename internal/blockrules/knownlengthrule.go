@@ -0,0 +1,204 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+	"strconv"
+)
+
+const knownLengthTemplate = "the length of %s is known to be %d; use HaveLen instead of Not(BeEmpty()), to also check the length"
+
+// KnownLengthRule finds `Not(BeEmpty())` assertions of a slice, that was built in the same block,
+// by a known number of appends of literal values; e.g.
+//
+//	s := []int{}
+//	s = append(s, 1)
+//	s = append(s, 2, 3)
+//	Expect(s).ToNot(BeEmpty())
+//
+// should be:
+//
+//	Expect(s).To(HaveLen(3))
+//
+// Any other use of the slice, other than gomega assertions, makes its length unknown.
+type KnownLengthRule struct{}
+
+func (r KnownLengthRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	lengths := map[gotypes.Object]int{}
+
+	for _, stmt := range stmts {
+		if gexp, ok := ctx.GetAssertion(stmt); ok {
+			ident, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.Ident)
+			if !ok {
+				// the actual value may change the slice; e.g. `Expect(fill(&s)).To(Succeed())`
+				r.forgetUsed(stmt, lengths, ctx)
+				continue
+			}
+
+			if !ctx.ConfigFor(stmt).ForceKnownLength {
+				continue
+			}
+
+			length, ok := lengths[ctx.Pass().TypesInfo.ObjectOf(ident)]
+			if !ok || length == 0 || gexp.GetMatcherInfo().MatcherName() != "BeEmpty" || !gexp.IsNegativeAssertion() {
+				continue
+			}
+
+			gexp.ReverseAssertionFuncLogic()
+			gexp.SetMatcherLen(&ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(length)})
+			ctx.ReportWithFix(stmt, gexp.GetClone(), knownLengthTemplate, ident.Name, length)
+			continue
+		}
+
+		if obj, length, ok := r.getKnownLength(stmt, lengths, ctx); ok {
+			lengths[obj] = length
+			continue
+		}
+
+		r.forgetUsed(stmt, lengths, ctx)
+	}
+}
+
+// getKnownLength returns the new length of the slice, if the statement is a declaration of an
+// empty or a literal slice, or an append of literal values to a slice with a known length
+func (r KnownLengthRule) getKnownLength(stmt ast.Stmt, lengths map[gotypes.Object]int, ctx *Context) (gotypes.Object, int, bool) {
+	switch s := stmt.(type) {
+	case *ast.DeclStmt:
+		genDecl, ok := s.Decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR || len(genDecl.Specs) != 1 {
+			return nil, 0, false
+		}
+
+		valueSpec, ok := genDecl.Specs[0].(*ast.ValueSpec)
+		if !ok || len(valueSpec.Names) != 1 || len(valueSpec.Values) > 1 {
+			return nil, 0, false
+		}
+
+		obj := ctx.Pass().TypesInfo.ObjectOf(valueSpec.Names[0])
+		if !r.isSlice(obj) {
+			return nil, 0, false
+		}
+
+		if len(valueSpec.Values) == 0 {
+			return obj, 0, true
+		}
+
+		if length, ok := r.getLiteralLength(valueSpec.Values[0]); ok {
+			return obj, length, true
+		}
+
+	case *ast.AssignStmt:
+		if len(s.Lhs) != 1 || len(s.Rhs) != 1 {
+			return nil, 0, false
+		}
+
+		ident, ok := s.Lhs[0].(*ast.Ident)
+		if !ok {
+			return nil, 0, false
+		}
+
+		obj := ctx.Pass().TypesInfo.ObjectOf(ident)
+		if !r.isSlice(obj) {
+			return nil, 0, false
+		}
+
+		if s.Tok == token.DEFINE {
+			if length, ok := r.getLiteralLength(s.Rhs[0]); ok {
+				return obj, length, true
+			}
+			return nil, 0, false
+		}
+
+		if s.Tok != token.ASSIGN {
+			return nil, 0, false
+		}
+
+		length, known := lengths[obj]
+		if !known {
+			return nil, 0, false
+		}
+
+		if added, ok := r.getAppendedLiterals(s.Rhs[0], obj, ctx); ok {
+			return obj, length + added, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// getLiteralLength returns the length of a slice literal with no keys, like `[]int{1, 2}`
+func (KnownLengthRule) getLiteralLength(expr ast.Expr) (int, bool) {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	if !ok {
+		return 0, false
+	}
+
+	if arr, ok := lit.Type.(*ast.ArrayType); !ok || arr.Len != nil {
+		return 0, false
+	}
+
+	for _, elt := range lit.Elts {
+		if _, ok := elt.(*ast.KeyValueExpr); ok {
+			return 0, false
+		}
+	}
+
+	return len(lit.Elts), true
+}
+
+// getAppendedLiterals returns the number of the appended values, if the expression is an append
+// to the slice, of literal values only; e.g. `append(s, 1, 2)`
+func (KnownLengthRule) getAppendedLiterals(expr ast.Expr, obj gotypes.Object, ctx *Context) (int, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || call.Ellipsis.IsValid() || len(call.Args) < 2 {
+		return 0, false
+	}
+
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || fun.Name != "append" {
+		return 0, false
+	}
+
+	if _, ok := ctx.Pass().TypesInfo.ObjectOf(fun).(*gotypes.Builtin); !ok {
+		return 0, false
+	}
+
+	first, ok := ast.Unparen(call.Args[0]).(*ast.Ident)
+	if !ok || ctx.Pass().TypesInfo.ObjectOf(first) != obj {
+		return 0, false
+	}
+
+	for _, arg := range call.Args[1:] {
+		switch ast.Unparen(arg).(type) {
+		case *ast.BasicLit, *ast.CompositeLit:
+		default:
+			return 0, false
+		}
+	}
+
+	return len(call.Args) - 1, true
+}
+
+func (KnownLengthRule) isSlice(obj gotypes.Object) bool {
+	if obj == nil {
+		return false
+	}
+
+	_, ok := obj.Type().Underlying().(*gotypes.Slice)
+	return ok
+}
+
+// forgetUsed removes the slices used by the statement, because their length is not known anymore
+func (KnownLengthRule) forgetUsed(stmt ast.Stmt, lengths map[gotypes.Object]int, ctx *Context) {
+	if len(lengths) == 0 {
+		return
+	}
+
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			delete(lengths, ctx.Pass().TypesInfo.ObjectOf(ident))
+		}
+		return true
+	})
+}
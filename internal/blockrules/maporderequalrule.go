@@ -0,0 +1,143 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+)
+
+const mapOrderEqualTemplate = "%s is built by iterating over a map, and so the order of its elements is random; use the ConsistOf matcher instead of Equal"
+
+// MapOrderEqualRule finds Equal assertions of a slice, that was built in the same block, by a range
+// loop over a map, that only appends the keys or the values of the map to the slice; e.g.
+//
+//	keys := []string{}
+//	for k := range m {
+//		keys = append(keys, k)
+//	}
+//	Expect(keys).To(Equal(want))
+//
+// should be:
+//
+//	Expect(keys).To(ConsistOf(want))
+//
+// Any other use of the slice between the loop and the assertion, like sorting it, makes the rule
+// skip the slice.
+type MapOrderEqualRule struct{}
+
+func (r MapOrderEqualRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	unordered := map[gotypes.Object]bool{}
+
+	for _, stmt := range stmts {
+		if obj, ok := r.getMapRangeAppend(stmt, ctx); ok {
+			unordered[obj] = true
+			continue
+		}
+
+		if len(unordered) == 0 {
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok {
+			r.forgetUsed(stmt, unordered, ctx)
+			continue
+		}
+
+		ident, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.Ident)
+		if !ok {
+			r.forgetUsed(stmt, unordered, ctx)
+			continue
+		}
+
+		if !unordered[ctx.Pass().TypesInfo.ObjectOf(ident)] || !ctx.ConfigFor(stmt).ForbidMapOrderEqual {
+			continue
+		}
+
+		if !gexp.MatcherTypeIs(matcher.EqualMatcherType) || gexp.HasAliasedMatcher() {
+			continue
+		}
+
+		gexp.ReplaceMatcherFuncName("ConsistOf")
+		ctx.ReportWithFix(stmt, gexp.GetClone(), mapOrderEqualTemplate, ident.Name)
+	}
+}
+
+// getMapRangeAppend checks if the statement is a range loop over a map, with a single statement,
+// that appends the key or the value to a slice; e.g. `for k := range m { keys = append(keys, k) }`.
+// It returns the object of the slice.
+func (MapOrderEqualRule) getMapRangeAppend(stmt ast.Stmt, ctx *Context) (gotypes.Object, bool) {
+	rangeStmt, ok := stmt.(*ast.RangeStmt)
+	if !ok || rangeStmt.Body == nil || len(rangeStmt.Body.List) != 1 {
+		return nil, false
+	}
+
+	rangeType := ctx.Pass().TypesInfo.TypeOf(rangeStmt.X)
+	if rangeType == nil {
+		return nil, false
+	}
+
+	if _, ok := rangeType.Underlying().(*gotypes.Map); !ok {
+		return nil, false
+	}
+
+	assign, ok := rangeStmt.Body.List[0].(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, false
+	}
+
+	slice, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || call.Ellipsis.IsValid() {
+		return nil, false
+	}
+
+	fun, ok := call.Fun.(*ast.Ident)
+	if !ok || fun.Name != "append" {
+		return nil, false
+	}
+
+	if _, ok := ctx.Pass().TypesInfo.ObjectOf(fun).(*gotypes.Builtin); !ok {
+		return nil, false
+	}
+
+	first, ok := call.Args[0].(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+
+	obj := ctx.Pass().TypesInfo.ObjectOf(slice)
+	if obj == nil || ctx.Pass().TypesInfo.ObjectOf(first) != obj {
+		return nil, false
+	}
+
+	elem, ok := ast.Unparen(call.Args[1]).(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+
+	elemObj := ctx.Pass().TypesInfo.ObjectOf(elem)
+	for _, loopVar := range []ast.Expr{rangeStmt.Key, rangeStmt.Value} {
+		if id, ok := loopVar.(*ast.Ident); ok && id.Name != "_" && ctx.Pass().TypesInfo.ObjectOf(id) == elemObj {
+			return obj, true
+		}
+	}
+
+	return nil, false
+}
+
+// forgetUsed removes the slices used by the statement, because they may be sorted or changed
+func (MapOrderEqualRule) forgetUsed(stmt ast.Stmt, unordered map[gotypes.Object]bool, ctx *Context) {
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			delete(unordered, ctx.Pass().TypesInfo.ObjectOf(ident))
+		}
+		return true
+	})
+}
@@ -0,0 +1,140 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+)
+
+const numericBoundsTemplate = `the assertions of %[1]s with BeNumerically(">=", %[2]s) and BeNumerically("<=", %[2]s) together mean that %[1]s equals %[2]s; use Equal instead; e.g. ` + "`%[3]s`"
+
+// numericBound is a `BeNumerically(">=", value)` or a `BeNumerically("<=", value)` assertion of a
+// variable
+type numericBound struct {
+	op    token.Token
+	value constant.Value
+	objs  []gotypes.Object
+}
+
+// NumericBoundsRule finds two assertions of the same integer variable, in the same block, with
+// complementary BeNumerically bounds of the same value; e.g.
+//
+//	Expect(n).To(BeNumerically(">=", 5))
+//	Expect(n).To(BeNumerically("<=", 5))
+//
+// should be:
+//
+//	Expect(n).To(Equal(5))
+//
+// Any other use of the variable between the assertions, other than gomega assertions, resets the
+// known bounds.
+type NumericBoundsRule struct{}
+
+func (r NumericBoundsRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	bounds := map[string]numericBound{}
+
+	for _, stmt := range stmts {
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok {
+			r.forgetUsed(stmt, bounds, ctx)
+			continue
+		}
+
+		key, bound, mtchr, ok := r.getBound(gexp, ctx)
+		if !ok {
+			continue
+		}
+
+		prev, found := bounds[key]
+		if !found || prev.op == bound.op || !constant.Compare(prev.value, token.EQL, bound.value) {
+			bounds[key] = bound
+			continue
+		}
+
+		delete(bounds, key)
+
+		if !ctx.ConfigFor(stmt).ForbidNumericBounds {
+			continue
+		}
+
+		valueExpr := mtchr.GetValueExpr()
+		gexp.SetMatcherEqual(valueExpr)
+		ctx.Report(stmt.(*ast.ExprStmt).X, numericBoundsTemplate, key, gotypes.ExprString(valueExpr), gotypes.ExprString(gexp.GetClone()))
+	}
+}
+
+// getBound returns the bound of a positive, synchronous, `BeNumerically(">=", value)` or
+// `BeNumerically("<=", value)` assertion, of an integer variable or field, with a constant value of
+// the same type
+func (NumericBoundsRule) getBound(gexp *expression.GomegaExpression, ctx *Context) (string, numericBound, *matcher.BeNumericallyMatcher, bool) {
+	if gexp.IsAsync() || gexp.IsNegativeAssertion() {
+		return "", numericBound{}, nil, false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.BeNumericallyMatcher)
+	if !ok || (mtchr.GetOp() != token.GEQ && mtchr.GetOp() != token.LEQ) || mtchr.GetValue() == nil {
+		return "", numericBound{}, nil, false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil || mtchr.GetType() == nil || !gotypes.Identical(mtchr.GetType(), actualType) {
+		return "", numericBound{}, nil, false
+	}
+
+	if basic, ok := actualType.Underlying().(*gotypes.Basic); !ok || basic.Info()&gotypes.IsInteger == 0 {
+		return "", numericBound{}, nil, false
+	}
+
+	actualExpr := ast.Unparen(gexp.GetOrigActualArgExpr())
+	var objs []gotypes.Object
+	ok = true
+	ast.Inspect(actualExpr, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.Ident:
+			if obj := ctx.Pass().TypesInfo.ObjectOf(node); obj != nil {
+				objs = append(objs, obj)
+			}
+		case *ast.SelectorExpr, nil:
+		default:
+			// only variables and fields are tracked; e.g. the result of a function call may
+			// change between the assertions
+			ok = false
+		}
+		return ok
+	})
+
+	if !ok || len(objs) == 0 {
+		return "", numericBound{}, nil, false
+	}
+
+	return gotypes.ExprString(actualExpr), numericBound{op: mtchr.GetOp(), value: mtchr.GetValue(), objs: objs}, mtchr, true
+}
+
+// forgetUsed removes the bounds of the variables that are used by the statement, because their
+// values may be changed
+func (NumericBoundsRule) forgetUsed(stmt ast.Stmt, bounds map[string]numericBound, ctx *Context) {
+	if len(bounds) == 0 {
+		return
+	}
+
+	used := map[gotypes.Object]bool{}
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			used[ctx.Pass().TypesInfo.ObjectOf(ident)] = true
+		}
+		return true
+	})
+
+	for key, bound := range bounds {
+		for _, obj := range bound.objs {
+			if used[obj] {
+				delete(bounds, key)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
+)
+
+const redundantSucceedTemplate = "the error of %[1]s is already stored in %[2]s, and %[2]s is checked; asserting %[1]s with Succeed() again is redundant, and calls the function again"
+
+// RedundantSucceedRule finds `Expect(f()).To(Succeed())` assertions, when the error of the same
+// call is also stored in a variable, and this variable is asserted, in the same block; e.g.
+//
+//	err := f()
+//	Expect(err).ToNot(HaveOccurred())
+//	Expect(f()).To(Succeed())
+//
+// The second assertion is redundant, and it also calls the function one more time.
+type RedundantSucceedRule struct{}
+
+func (r RedundantSucceedRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	stored := map[string]gotypes.Object{}
+	checked := map[gotypes.Object]bool{}
+	assigned := map[gotypes.Object]int{}
+
+	for _, stmt := range stmts {
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					assigned[ctx.Pass().TypesInfo.ObjectOf(ident)]++
+				}
+			}
+			r.addStoredError(assign, stored, ctx)
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok {
+			continue
+		}
+
+		if ident, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.Ident); ok {
+			if obj := ctx.Pass().TypesInfo.ObjectOf(ident); obj != nil {
+				checked[obj] = true
+			}
+		}
+	}
+
+	if len(stored) == 0 || len(checked) == 0 {
+		return
+	}
+
+	for _, stmt := range stmts {
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || gexp.IsAsync() || gexp.IsNegativeAssertion() || gexp.GetMatcherInfo().MatcherName() != "Succeed" {
+			continue
+		}
+
+		call, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.CallExpr)
+		if !ok || !ctx.ConfigFor(stmt).ForbidRedundantSucceed {
+			continue
+		}
+
+		obj, ok := stored[gotypes.ExprString(call)]
+		// a variable that is assigned more than once, may hold the error of another call when it is
+		// checked
+		if !ok || !checked[obj] || assigned[obj] > 1 {
+			continue
+		}
+
+		ctx.Report(call, redundantSucceedTemplate, gotypes.ExprString(call), obj.Name())
+	}
+}
+
+// addStoredError records the error variable of a function call assignment, like `err := f()`.
+// Functions with multiple return values are skipped, because Succeed() does not support them.
+func (RedundantSucceedRule) addStoredError(assign *ast.AssignStmt, stored map[string]gotypes.Object, ctx *Context) {
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+
+	call, ok := ast.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	ident, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || ident.Name == "_" {
+		return
+	}
+
+	obj := ctx.Pass().TypesInfo.ObjectOf(ident)
+	if obj == nil || !interfaces.ImplementsError(obj.Type()) {
+		return
+	}
+
+	stored[gotypes.ExprString(call)] = obj
+}
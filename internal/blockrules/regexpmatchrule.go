@@ -0,0 +1,92 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+)
+
+const regexpMatchVarTemplate = "asserting %[1]s, the result of %[2]s; use the MatchRegexp matcher instead, for a better failure message; e.g. `Expect(%[3]s).To(MatchRegexp(%[4]s))`"
+
+// RegexpMatchRule finds boolean assertions of a variable, that was assigned in the same block, with
+// the result of regexp.MatchString, or of the MatchString method of a regular expression that is
+// compiled by regexp.MustCompile; e.g.
+//
+//	matched, err := regexp.MatchString(p, s)
+//	Expect(err).ToNot(HaveOccurred())
+//	Expect(matched).To(BeTrue())
+//
+// should be:
+//
+//	Expect(s).To(MatchRegexp(p))
+//
+// The code may use the variable or the error for other purposes, so the issue is reported with no
+// suggested fix. Like the expression rule, it is suppressed with the comparison checks.
+type RegexpMatchRule struct{}
+
+func (r RegexpMatchRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	matches := map[gotypes.Object]*actual.RegexpMatchPayload{}
+
+	for _, stmt := range stmts {
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			r.updateFromAssignment(assign, matches, ctx)
+			continue
+		}
+
+		if len(matches) == 0 {
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || !gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse) || ctx.ConfigFor(stmt).SuppressCompare {
+			continue
+		}
+
+		ident, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		match, ok := matches[ctx.Pass().TypesInfo.ObjectOf(ident)]
+		if !ok {
+			continue
+		}
+
+		ctx.Report(ident, regexpMatchVarTemplate, ident.Name, match.FuncName(), ctx.FormatExpr(match.GetSubject()), ctx.FormatExpr(match.GetPattern()))
+	}
+}
+
+// updateFromAssignment keeps the variables that are assigned with the result of a regexp matching
+// call, and forgets the variables that are assigned with any other value
+func (RegexpMatchRule) updateFromAssignment(assign *ast.AssignStmt, matches map[gotypes.Object]*actual.RegexpMatchPayload, ctx *Context) {
+	for _, lhs := range assign.Lhs {
+		if ident, ok := lhs.(*ast.Ident); ok {
+			delete(matches, ctx.Pass().TypesInfo.ObjectOf(ident))
+		}
+	}
+
+	if len(assign.Rhs) != 1 {
+		return
+	}
+
+	call, ok := ast.Unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	match, ok := actual.GetRegexpMatch(call, ctx.Pass())
+	if !ok {
+		return
+	}
+
+	ident, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok || ident.Name == "_" {
+		return
+	}
+
+	if obj := ctx.Pass().TypesInfo.ObjectOf(ident); obj != nil {
+		matches[obj] = match
+	}
+}
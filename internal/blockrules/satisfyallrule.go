@@ -0,0 +1,89 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/reverseassertion"
+)
+
+const satisfyAllTemplate = "the %d assertions of %s can be merged into a single SatisfyAll assertion"
+
+// SatisfyAllRule finds consecutive assertions of the same actual value, in the same block, and
+// suggests merging them into a single assertion, with the SatisfyAll matcher; e.g.
+//
+//	Expect(x).To(BeNumerically(">", 0))
+//	Expect(x).To(BeNumerically("<", 10))
+//
+// should be:
+//
+//	Expect(x).To(SatisfyAll(BeNumerically(">", 0), BeNumerically("<", 10)))
+//
+// The actual value must be a variable, a field or a literal, so it is known to be the same in all
+// the assertions, and all the assertions must use the same actual and assertion functions.
+type SatisfyAllRule struct{}
+
+func (r SatisfyAllRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for i := 0; i < len(stmts); {
+		key, actualExpr, ok := r.getKey(stmts[i], ctx)
+		if !ok {
+			i++
+			continue
+		}
+
+		j := i + 1
+		for ; j < len(stmts); j++ {
+			if next, _, ok := r.getKey(stmts[j], ctx); !ok || next != key {
+				break
+			}
+		}
+
+		if j-i > 1 && ctx.ConfigFor(stmts[i]).ForceSatisfyAll {
+			r.report(stmts[i:j], actualExpr, ctx)
+		}
+
+		i = j
+	}
+}
+
+// getKey returns the actual and the assertion functions of a synchronous assertion of a stable
+// actual value, with a positive assertion function and no additional arguments, as a string; e.g.
+// `Expect(x).To`. The matcher itself may be wrapped with Not, because it is moved as is into the
+// SatisfyAll matcher.
+func (SatisfyAllRule) getKey(stmt ast.Stmt, ctx *Context) (string, ast.Expr, bool) {
+	gexp, ok := ctx.GetAssertion(stmt)
+	if !ok || gexp.IsAsync() || reverseassertion.IsNegativeLogic(gexp.GetOrigAssertFuncName()) {
+		return "", nil, false
+	}
+
+	call := stmt.(*ast.ExprStmt).X.(*ast.CallExpr)
+	if len(call.Args) != 1 {
+		return "", nil, false
+	}
+
+	actualExpr := ast.Unparen(gexp.GetOrigActualArgExpr())
+	if !isStableExpr(actualExpr) {
+		return "", nil, false
+	}
+
+	return gotypes.ExprString(call.Fun), actualExpr, true
+}
+
+func (SatisfyAllRule) report(group []ast.Stmt, actualExpr ast.Expr, ctx *Context) {
+	first, _ := ctx.GetAssertion(group[0])
+
+	// the matchers are moved from the next lines, so they are added as a formatted text, to keep
+	// the fixed assertion in one line
+	matchers := make([]ast.Expr, 0, len(group))
+	for _, stmt := range group {
+		matchers = append(matchers, ast.NewIdent(ctx.FormatExpr(stmt.(*ast.ExprStmt).X.(*ast.CallExpr).Args[0])))
+	}
+
+	satisfyAll := ctx.handler.GetNewWrapperMatcher("SatisfyAll", nil)
+	satisfyAll.Args = matchers
+
+	fix := first.GetClone()
+	fix.Args = []ast.Expr{satisfyAll}
+
+	ctx.ReportMergeWithFix(group[0], group[len(group)-1], fix, satisfyAllTemplate, len(group), gotypes.ExprString(actualExpr))
+}
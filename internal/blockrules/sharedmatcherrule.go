@@ -0,0 +1,120 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/gomegainfo"
+)
+
+const sharedMatcherTemplate = "the %s matcher in %s is used by more than one assertion; gomega matchers may keep a state from a previous match, like the Receive matcher; create a new matcher for each assertion"
+
+// sharedMatcher is a gomega matcher, that is stored in a variable
+type sharedMatcher struct {
+	name string
+	used bool
+}
+
+// SharedMatcherRule finds a gomega matcher, that is stored in a variable, and is used by more than
+// one assertion in the same block; e.g.
+//
+//	m := Receive(&x)
+//	Expect(ch1).To(m)
+//	Expect(ch2).To(m)
+//
+// Some gomega matchers keep a state from the previous match, so a matcher instance should not be
+// shared between assertions. Assigning a new value to the variable resets its usage.
+type SharedMatcherRule struct{}
+
+func (r SharedMatcherRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	matchers := map[gotypes.Object]*sharedMatcher{}
+
+	for _, stmt := range stmts {
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			r.trackAssignment(assign, matchers, ctx)
+			continue
+		}
+
+		if len(matchers) == 0 {
+			continue
+		}
+
+		call, ok := r.getAssertionCall(stmt, ctx)
+		if !ok || !ctx.ConfigFor(stmt).ForbidSharedMatcher {
+			continue
+		}
+
+		for _, arg := range call.Args {
+			ast.Inspect(arg, func(n ast.Node) bool {
+				ident, ok := n.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				m, ok := matchers[ctx.Pass().TypesInfo.ObjectOf(ident)]
+				if !ok {
+					return true
+				}
+
+				if m.used {
+					ctx.Report(ident, sharedMatcherTemplate, m.name, ident.Name)
+				}
+				m.used = true
+
+				return true
+			})
+		}
+	}
+}
+
+// trackAssignment starts tracking the variables that are assigned with a gomega matcher, and stops
+// tracking the variables that are assigned with anything else
+func (SharedMatcherRule) trackAssignment(assign *ast.AssignStmt, matchers map[gotypes.Object]*sharedMatcher, ctx *Context) {
+	for i, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		obj := ctx.Pass().TypesInfo.ObjectOf(ident)
+		if obj == nil {
+			continue
+		}
+
+		delete(matchers, obj)
+
+		if len(assign.Lhs) != len(assign.Rhs) {
+			continue
+		}
+
+		call, ok := ast.Unparen(assign.Rhs[i]).(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		if info, ok := ctx.handler.GetGomegaBasicInfo(call); ok && !info.UseGomegaVar && gomegainfo.IsMatcherName(info.MethodName) {
+			matchers[obj] = &sharedMatcher{name: info.MethodName}
+		}
+	}
+}
+
+// getAssertionCall returns the assertion call of the statement, like `Expect(x).To(m)`. The gomega
+// expression can't be used here, because the matcher is not a function call.
+func (SharedMatcherRule) getAssertionCall(stmt ast.Stmt, ctx *Context) (*ast.CallExpr, bool) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, false
+	}
+
+	call, ok := exprStmt.X.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !gomegainfo.IsAssertionFunc(sel.Sel.Name) {
+		return nil, false
+	}
+
+	return call, ctx.handler.GetActualExpr(sel) != nil
+}
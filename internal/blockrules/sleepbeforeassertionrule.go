@@ -0,0 +1,48 @@
+package blockrules
+
+import (
+	"go/ast"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+const sleepBeforeAssertionTemplate = "time.Sleep right before an assertion is flaky; use Eventually or Consistently to wait for the expected state, instead of sleeping for a fixed time"
+
+// SleepBeforeAssertionRule finds a time.Sleep call, that is immediately followed by a synchronous
+// gomega assertion in the same block; e.g.
+//
+//	time.Sleep(time.Second)
+//	Expect(isReady()).To(BeTrue())
+//
+// and suggests using Eventually instead.
+type SleepBeforeAssertionRule struct{}
+
+func (r SleepBeforeAssertionRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	for i := 0; i < len(stmts)-1; i++ {
+		sleepCall, ok := r.getSleepCall(stmts[i], ctx)
+		if !ok || !ctx.ConfigFor(stmts[i+1]).ForbidSleepBeforeAssertion {
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmts[i+1])
+		if !ok || gexp.IsAsync() {
+			continue
+		}
+
+		ctx.Report(sleepCall, sleepBeforeAssertionTemplate)
+	}
+}
+
+func (SleepBeforeAssertionRule) getSleepCall(stmt ast.Stmt, ctx *Context) (*ast.CallExpr, bool) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, false
+	}
+
+	call, ok := exprStmt.X.(*ast.CallExpr)
+	if !ok || !funccall.IsPkgFunc(ctx.Pass(), call, "time", "Sleep") {
+		return nil, false
+	}
+
+	return call, true
+}
@@ -0,0 +1,128 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+)
+
+const typedNilTemplate = "%[1]s is an interface, that is assigned with a value of the %[2]s type; %[3]s() treats %[1]s as nil when it holds a nil %[2]s, but %[1]s itself is not nil, and `%[1]s == nil` is false; assert the concrete value instead"
+
+// TypedNilRule finds BeNil() and HaveOccurred() assertions of an interface variable, that was assigned with a value of
+// a concrete pointer type, in the same block; e.g.
+//
+//	var p *MyErr
+//	var err error = p
+//	Expect(err).To(BeNil())
+//
+// Gomega's BeNil() and HaveOccurred() treat a nil pointer in an interface as nil, but the interface
+// itself is not nil; so the assertion hides the nil interface trap, of code that checks
+// `err == nil`.
+//
+// An address, like `&x`, and a `new()` call, are never nil, and they are not tracked.
+type TypedNilRule struct{}
+
+func (r TypedNilRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	assigned := map[gotypes.Object]gotypes.Type{}
+
+	for _, stmt := range stmts {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			r.updateFromAssignment(s.Lhs, s.Rhs, assigned, ctx)
+			continue
+
+		case *ast.DeclStmt:
+			if genDecl, ok := s.Decl.(*ast.GenDecl); ok && genDecl.Tok == token.VAR {
+				for _, spec := range genDecl.Specs {
+					if valueSpec, ok := spec.(*ast.ValueSpec); ok {
+						lhs := make([]ast.Expr, 0, len(valueSpec.Names))
+						for _, name := range valueSpec.Names {
+							lhs = append(lhs, name)
+						}
+						r.updateFromAssignment(lhs, valueSpec.Values, assigned, ctx)
+					}
+				}
+			}
+			continue
+		}
+
+		if len(assigned) == 0 {
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok || !ctx.ConfigFor(stmt).ForbidTypedNilInterface {
+			continue
+		}
+
+		matcherName := gexp.GetMatcherInfo().MatcherName()
+		if matcherName != "BeNil" && matcherName != "HaveOccurred" {
+			continue
+		}
+
+		ident, ok := ast.Unparen(gexp.GetOrigActualArgExpr()).(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if concrete, ok := assigned[ctx.Pass().TypesInfo.ObjectOf(ident)]; ok {
+			ctx.Report(ident, typedNilTemplate, ident.Name, gotypes.TypeString(concrete, gotypes.RelativeTo(ctx.Pass().Pkg)), matcherName)
+		}
+	}
+}
+
+// updateFromAssignment tracks the interface variables that are assigned with a value of a concrete
+// pointer type, and stops tracking the variables that are assigned with any other value
+func (r TypedNilRule) updateFromAssignment(lhs, rhs []ast.Expr, assigned map[gotypes.Object]gotypes.Type, ctx *Context) {
+	for i, l := range lhs {
+		ident, ok := l.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		obj := ctx.Pass().TypesInfo.ObjectOf(ident)
+		if obj == nil {
+			continue
+		}
+		delete(assigned, obj)
+
+		if len(lhs) != len(rhs) || !gotypes.IsInterface(obj.Type()) {
+			continue
+		}
+
+		if concrete, ok := r.getConcretePointerType(rhs[i], ctx); ok {
+			assigned[obj] = concrete
+		}
+	}
+}
+
+// getConcretePointerType returns the type of the assigned value, if it is a concrete pointer type,
+// that may be nil. A conversion to an interface, like `error(p)`, is unwrapped.
+func (TypedNilRule) getConcretePointerType(expr ast.Expr, ctx *Context) (gotypes.Type, bool) {
+	expr = ast.Unparen(expr)
+	if conv, ok := expr.(*ast.CallExpr); ok && len(conv.Args) == 1 && ctx.Pass().TypesInfo.Types[conv.Fun].IsType() {
+		expr = ast.Unparen(conv.Args[0])
+	}
+
+	switch e := expr.(type) {
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return nil, false
+		}
+	case *ast.CallExpr:
+		if fun, ok := e.Fun.(*ast.Ident); ok && fun.Name == "new" {
+			return nil, false
+		}
+	}
+
+	t := ctx.Pass().TypesInfo.TypeOf(expr)
+	if t == nil || gotypes.IsInterface(t) {
+		return nil, false
+	}
+
+	if _, ok := t.Underlying().(*gotypes.Pointer); !ok {
+		return nil, false
+	}
+
+	return t, true
+}
@@ -0,0 +1,89 @@
+package blockrules
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+)
+
+const unguardedDerefTemplate = "asserting %[1]s panics if %[2]s is nil, before gomega checks anything; assert that %[2]s is not nil first, or use the HaveValue matcher (or gstruct's PointTo); e.g. `Expect(%[2]s).ToNot(BeNil())`"
+
+// UnguardedDerefRule finds assertions of a pointer dereference, like `Expect(*p)`, with no
+// assertion that the pointer is not nil before, in the same block. If the pointer is nil, the test
+// panics with a nil pointer dereference, instead of failing with a gomega message; e.g.
+//
+//	Expect(*p).To(Equal(v))
+//
+// should be:
+//
+//	Expect(p).ToNot(BeNil())
+//	Expect(*p).To(Equal(v))
+//
+// A pointer that is assigned with an address (`&x`) or with `new()` in the same block, is also
+// considered as guarded.
+type UnguardedDerefRule struct{}
+
+func (r UnguardedDerefRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	guarded := map[string]bool{}
+	for _, stmt := range stmts {
+		if assign, ok := stmt.(*ast.AssignStmt); ok {
+			r.updateFromAssignment(assign, guarded)
+			continue
+		}
+
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok {
+			continue
+		}
+
+		actualArg := ast.Unparen(gexp.GetOrigActualArgExpr())
+		if star, ok := actualArg.(*ast.StarExpr); ok && ctx.ConfigFor(stmt).ForbidUnguardedDeref {
+			ptr := gotypes.ExprString(ast.Unparen(star.X))
+			if !guarded[ptr] {
+				ctx.Report(star, unguardedDerefTemplate, gotypes.ExprString(star), ptr)
+			}
+			continue
+		}
+
+		if isNotNilGuard(gexp) {
+			guarded[gotypes.ExprString(actualArg)] = true
+		}
+	}
+}
+
+// updateFromAssignment marks the pointers that are assigned with an address or with new() as
+// guarded, and removes the guard from pointers that are assigned with any other value
+func (UnguardedDerefRule) updateFromAssignment(assign *ast.AssignStmt, guarded map[string]bool) {
+	for i, lhs := range assign.Lhs {
+		name := gotypes.ExprString(ast.Unparen(lhs))
+		delete(guarded, name)
+
+		if len(assign.Lhs) != len(assign.Rhs) {
+			continue
+		}
+
+		switch rhs := ast.Unparen(assign.Rhs[i]).(type) {
+		case *ast.UnaryExpr:
+			if rhs.Op == token.AND {
+				guarded[name] = true
+			}
+		case *ast.CallExpr:
+			if fun, ok := rhs.Fun.(*ast.Ident); ok && fun.Name == "new" {
+				guarded[name] = true
+			}
+		}
+	}
+}
+
+// isNotNilGuard checks if the assertion verifies that its actual value is not nil; i.e.
+// `ToNot(BeNil())`, or a positive assertion with the HaveValue matcher
+func isNotNilGuard(gexp *expression.GomegaExpression) bool {
+	matcherName := gexp.GetMatcherInfo().MatcherName()
+	if gexp.IsNegativeAssertion() {
+		return matcherName == "BeNil"
+	}
+
+	return matcherName == "HaveValue"
+}
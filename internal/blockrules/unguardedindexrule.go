@@ -0,0 +1,116 @@
+package blockrules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+)
+
+const unguardedIndexTemplate = "asserting %[1]s panics if %[2]s is too short, before gomega checks anything; assert the length first; e.g. `Expect(%[2]s).To(HaveLen(n))` or `Expect(%[2]s).ToNot(BeEmpty())`"
+
+// lengthGuardMatchers are the matchers that verify the length of the slice, when used in a positive
+// assertion of the slice itself. The only negative assertion that guards the index is
+// `ToNot(BeEmpty())`.
+var lengthGuardMatchers = map[string]bool{
+	"HaveLen":           true,
+	"ConsistOf":         true,
+	"HaveExactElements": true,
+	"Equal":             true,
+	"BeEquivalentTo":    true,
+}
+
+// UnguardedIndexRule finds assertions of a constant index of a slice, like `Expect(s[0])`, with no
+// assertion of the slice length before, in the same block. If the slice is shorter than expected,
+// the test panics with an index out of range error, instead of failing with a gomega message; e.g.
+//
+//	Expect(s[0]).To(Equal(v))
+//
+// should be:
+//
+//	Expect(s).ToNot(BeEmpty())
+//	Expect(s[0]).To(Equal(v))
+type UnguardedIndexRule struct{}
+
+func (r UnguardedIndexRule) Apply(stmts []ast.Stmt, ctx *Context) {
+	var guarded []*expression.GomegaExpression
+	for _, stmt := range stmts {
+		gexp, ok := ctx.GetAssertion(stmt)
+		if !ok {
+			continue
+		}
+
+		if ctx.ConfigFor(stmt).ForbidUnguardedIndex {
+			if idx, ok := r.getConstSliceIndex(gexp.GetOrigActualArgExpr(), ctx); ok && !r.isGuarded(idx.X, guarded) {
+				ctx.Report(gexp.GetOrigActualArgExpr(), unguardedIndexTemplate, gotypes.ExprString(idx), gotypes.ExprString(idx.X))
+			}
+		}
+
+		guarded = append(guarded, gexp)
+	}
+}
+
+// getConstSliceIndex returns the index expression, if it is a constant index of a slice
+func (UnguardedIndexRule) getConstSliceIndex(expr ast.Expr, ctx *Context) (*ast.IndexExpr, bool) {
+	idx, ok := ast.Unparen(expr).(*ast.IndexExpr)
+	if !ok {
+		return nil, false
+	}
+
+	if tv, ok := ctx.Pass().TypesInfo.Types[idx.Index]; !ok || tv.Value == nil {
+		return nil, false
+	}
+
+	t := ctx.Pass().TypesInfo.TypeOf(idx.X)
+	if t == nil {
+		return nil, false
+	}
+
+	if _, ok := t.Underlying().(*gotypes.Slice); !ok {
+		return nil, false
+	}
+
+	return idx, true
+}
+
+// isGuarded checks if one of the previous assertions verifies the length of the slice; i.e. an
+// assertion using `len(s)`, or an assertion of the slice itself, using a matcher that checks its
+// length or content
+func (UnguardedIndexRule) isGuarded(slice ast.Expr, prevAssertions []*expression.GomegaExpression) bool {
+	sliceStr := gotypes.ExprString(ast.Unparen(slice))
+	for _, gexp := range prevAssertions {
+		actualArg := gexp.GetOrigActualArgExpr()
+		if gotypes.ExprString(ast.Unparen(actualArg)) == sliceStr {
+			if isLengthGuard(gexp) {
+				return true
+			}
+			continue
+		}
+
+		found := false
+		ast.Inspect(actualArg, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && len(call.Args) == 1 {
+				if fun, ok := call.Fun.(*ast.Ident); ok && fun.Name == "len" && gotypes.ExprString(ast.Unparen(call.Args[0])) == sliceStr {
+					found = true
+				}
+			}
+			return !found
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isLengthGuard checks if an assertion of the slice itself verifies its length
+func isLengthGuard(gexp *expression.GomegaExpression) bool {
+	matcherName := gexp.GetMatcherInfo().MatcherName()
+	if gexp.IsNegativeAssertion() {
+		return matcherName == "BeEmpty"
+	}
+
+	return lengthGuardMatchers[matcherName]
+}
@@ -6,8 +6,10 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"github.com/nunnatsa/ginkgolinter/internal/expression/value"
 	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
 	"github.com/nunnatsa/ginkgolinter/internal/gomegainfo"
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
 )
 
 type Actual struct {
@@ -16,9 +18,23 @@ type Actual struct {
 	Arg          ArgPayload
 	argType      gotypes.Type
 	isTuple      bool
+	tupleErr     bool
 	isAsync      bool
 	asyncArg     *AsyncArg
 	actualOffset int
+	convOperand  ast.Expr
+	stateMethod  string
+	isMethodExpr bool
+	isFloatCalc  bool
+	isConstCalc  bool
+	isConst      bool
+	isCtxDone    bool
+	isTimeNow    bool
+	bufferRecv   ast.Expr
+	errStr       ast.Expr
+	errStrKind   ErrorStringKind
+	transform    *ast.CallExpr
+	syncMapLoad  string
 }
 
 func New(origExpr, cloneExpr *ast.CallExpr, orig *ast.CallExpr, clone *ast.CallExpr, pass *analysis.Pass, timePkg string, info *gomegahandler.GomegaBasicInfo) (*Actual, bool) {
@@ -29,6 +45,7 @@ func New(origExpr, cloneExpr *ast.CallExpr, orig *ast.CallExpr, clone *ast.CallE
 
 	argType := pass.TypesInfo.TypeOf(orig.Args[actualOffset])
 	isTuple := false
+	tupleErr := false
 
 	if tpl, ok := argType.(*gotypes.Tuple); ok {
 		if tpl.Len() > 0 {
@@ -38,6 +55,7 @@ func New(origExpr, cloneExpr *ast.CallExpr, orig *ast.CallExpr, clone *ast.CallE
 		}
 
 		isTuple = tpl.Len() > 1
+		tupleErr = isTuple && interfaces.ImplementsError(tpl.At(tpl.Len()-1).Type())
 	}
 
 	isAsyncExpr := gomegainfo.IsAsyncActualMethod(info.MethodName)
@@ -47,15 +65,31 @@ func New(origExpr, cloneExpr *ast.CallExpr, orig *ast.CallExpr, clone *ast.CallE
 		asyncArg = newAsyncArg(origExpr, cloneExpr, orig, clone, argType, pass, actualOffset, timePkg)
 	}
 
+	errStr, errStrKind := getErrorString(orig.Args[actualOffset], clone.Args[actualOffset], pass)
+
 	return &Actual{
 		Orig:         orig,
 		Clone:        clone,
 		Arg:          arg,
 		argType:      argType,
 		isTuple:      isTuple,
+		tupleErr:     tupleErr,
 		isAsync:      isAsyncExpr,
 		asyncArg:     asyncArg,
 		actualOffset: actualOffset,
+		convOperand:  getRedundantConversionOperand(orig.Args[actualOffset], clone.Args[actualOffset], pass),
+		stateMethod:  getTestingStateMethod(orig.Args[actualOffset], pass),
+		isMethodExpr: isMethodExpression(orig.Args[actualOffset], pass),
+		isFloatCalc:  hasFloatArithmetic(orig.Args[actualOffset], pass),
+		isConstCalc:  isConstantCalc(orig.Args[actualOffset], clone.Args[actualOffset], pass),
+		isConst:      value.New(orig.Args[actualOffset], clone.Args[actualOffset], pass).GetValue() != nil,
+		isCtxDone:    isContextDone(orig.Args[actualOffset], pass),
+		isTimeNow:    isTimeNow(orig.Args[actualOffset], pass),
+		bufferRecv:   getBufferBytesReceiver(orig.Args[actualOffset], clone.Args[actualOffset], pass),
+		errStr:       errStr,
+		errStrKind:   errStrKind,
+		transform:    getTransformCall(orig.Args[actualOffset], clone.Args[actualOffset], pass),
+		syncMapLoad:  getSyncMapLoadMethod(orig.Args[actualOffset], pass),
 	}, true
 }
 
@@ -76,6 +110,12 @@ func (a *Actual) IsTuple() bool {
 	return a.isTuple
 }
 
+// IsTupleWithError returns true if the actual value is a function call with multiple results, and
+// its last result is an error; e.g. `Expect(strconv.Atoi(s))`
+func (a *Actual) IsTupleWithError() bool {
+	return a.tupleErr
+}
+
 func (a *Actual) ArgGOType() gotypes.Type {
 	return a.argType
 }
@@ -108,6 +148,101 @@ func (a *Actual) AppendWithArgsMethod() {
 	}
 }
 
+// MoveOffsetToMethod moves the offset argument of an actual function with offset, like
+// ExpectWithOffset, to the WithOffset method; e.g. `ExpectWithOffset(1, x)` => `Expect(x).WithOffset(1)`.
+// The caller is expected to replace the function name.
+func (a *Actual) MoveOffsetToMethod() {
+	offset := a.Clone.Args[0]
+	call := &ast.CallExpr{
+		Fun:  a.Clone.Fun,
+		Args: append([]ast.Expr{}, a.Clone.Args[1:]...),
+	}
+
+	a.Clone.Fun = &ast.SelectorExpr{
+		X:   call,
+		Sel: ast.NewIdent("WithOffset"),
+	}
+	a.Clone.Args = []ast.Expr{offset}
+	a.Clone = call
+	a.actualOffset--
+}
+
 func (a *Actual) GetActualArg() ast.Expr {
 	return a.Clone.Args[a.actualOffset]
 }
+
+// GetOrigActualArg returns the actual argument from the original expression, for type checking
+func (a *Actual) GetOrigActualArg() ast.Expr {
+	return a.Orig.Args[a.actualOffset]
+}
+
+// GetRedundantConversionOperand returns the converted value of the actual argument, if this
+// argument is a conversion of a value to its own type; e.g. `Expect(int(x))`
+func (a *Actual) GetRedundantConversionOperand() (ast.Expr, bool) {
+	return a.convOperand, a.convOperand != nil
+}
+
+// GetTestingStateMethod returns the name of the testing framework method, if the actual argument
+// is a call to a method that returns the state of the test; e.g. `Expect(t.Failed())`
+func (a *Actual) GetTestingStateMethod() (string, bool) {
+	return a.stateMethod, a.stateMethod != ""
+}
+
+// IsMethodExpression checks if the actual argument is an unbound method expression; e.g.
+// `Expect(T.Method)`
+func (a *Actual) IsMethodExpression() bool {
+	return a.isMethodExpr
+}
+
+// HasFloatArithmetic checks if the actual argument includes an arithmetic operation of float
+// values; e.g. `Expect(a / b)`
+func (a *Actual) HasFloatArithmetic() bool {
+	return a.isFloatCalc
+}
+
+// IsConstantCalc checks if the actual argument is an arithmetic expression of constants; e.g.
+// `Expect(2 + 2)`
+func (a *Actual) IsConstantCalc() bool {
+	return a.isConstCalc
+}
+
+// IsConstant checks if the actual argument is a constant; e.g. `Expect(42)`
+func (a *Actual) IsConstant() bool {
+	return a.isConst
+}
+
+// IsContextDone checks if the actual argument is a call of the Done method of a context; e.g.
+// `Expect(ctx.Done())`
+func (a *Actual) IsContextDone() bool {
+	return a.isCtxDone
+}
+
+// IsTimeNow checks if the actual argument is a call of the time.Now function; e.g.
+// `Expect(time.Now())`
+func (a *Actual) IsTimeNow() bool {
+	return a.isTimeNow
+}
+
+// GetBufferBytesReceiver returns the bytes.Buffer receiver, if the actual argument is a call to its
+// Bytes() method; e.g. the `buf` in `Expect(buf.Bytes())`
+func (a *Actual) GetBufferBytesReceiver() (ast.Expr, bool) {
+	return a.bufferRecv, a.bufferRecv != nil
+}
+
+// GetTransformCall returns the call, if the actual argument is a call to a package level function,
+// that transforms a single value; e.g. `strings.ToLower(s)`
+func (a *Actual) GetTransformCall() (*ast.CallExpr, bool) {
+	return a.transform, a.transform != nil
+}
+
+// GetSyncMapLoadMethod returns the method name, if the actual argument is a call to a sync.Map
+// method, that returns a value and a boolean; e.g. `m.Load(key)`
+func (a *Actual) GetSyncMapLoadMethod() (string, bool) {
+	return a.syncMapLoad, a.syncMapLoad != ""
+}
+
+// GetErrorString returns the error, if the actual argument converts it to a string; e.g.
+// `Expect(err.Error())` or `Expect(fmt.Sprintf("%v", err))`
+func (a *Actual) GetErrorString() (ast.Expr, ErrorStringKind) {
+	return a.errStr, a.errStrKind
+}
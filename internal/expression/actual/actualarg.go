@@ -2,6 +2,7 @@ package actual
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 	gotypes "go/types"
 
@@ -30,6 +31,18 @@ const (
 	GomegaParamArgType
 	MultiRetsArgType
 	ErrorMethodArgType
+	BoolLiteralArgType
+	ErrorsIsArgType
+	ErrorsAsArgType
+	ElementOfArgType
+	RuneCountArgType
+	OsIsErrorArgType
+	StringsFuncArgType
+	RegexpMatchArgType
+	DeepEqualArgType
+	StrconvFormatArgType
+	TimeCompareArgType
+	StringIndexArgType
 
 	ErrorTypeArgType
 
@@ -56,10 +69,25 @@ func getActualArgPayload(origActualExpr, actualExprClone *ast.CallExpr, pass *an
 	} else {
 		switch expr := origArgExpr.(type) {
 		case *ast.CallExpr:
-			arg = newFuncCallArgPayload(expr, argExprClone.(*ast.CallExpr))
+			arg = newFuncCallArgPayload(expr, argExprClone.(*ast.CallExpr), pass)
+			if arg == nil {
+				arg = newPkgFuncCallPayload(expr, argExprClone.(*ast.CallExpr), pass)
+			}
+			if arg == nil {
+				arg = newRegexpMatchPayload(expr, argExprClone.(*ast.CallExpr), pass)
+			}
+			if arg == nil {
+				arg = newTimeComparePayload(expr, argExprClone.(*ast.CallExpr), pass)
+			}
 
 		case *ast.BinaryExpr:
 			arg = parseBinaryExpr(expr, argExprClone.(*ast.BinaryExpr), pass)
+
+		case *ast.Ident:
+			arg = newBoolLiteralPayload(expr, argExprClone, pass)
+
+		case *ast.IndexExpr:
+			arg = newStringIndexPayload(expr, argExprClone, pass)
 		}
 
 	}
@@ -130,6 +158,32 @@ func (*RegularArgPayload) ArgType() ArgType {
 	return UnknownActualArgType
 }
 
+// BoolLiteralPayload is an actual argument that is the true or the false literal
+type BoolLiteralPayload struct {
+	RegularArgPayload
+	val bool
+}
+
+func newBoolLiteralPayload(orig *ast.Ident, clone ast.Expr, pass *analysis.Pass) ArgPayload {
+	c, ok := pass.TypesInfo.Uses[orig].(*gotypes.Const)
+	if !ok || c.Parent() != gotypes.Universe || c.Val().Kind() != constant.Bool {
+		return nil
+	}
+
+	return &BoolLiteralPayload{
+		RegularArgPayload: *newRegularArgPayload(orig, clone, pass),
+		val:               constant.BoolVal(c.Val()),
+	}
+}
+
+func (*BoolLiteralPayload) ArgType() ArgType {
+	return BoolLiteralArgType
+}
+
+func (b *BoolLiteralPayload) GetBoolValue() bool {
+	return b.val
+}
+
 type FuncCallArgPayload struct {
 	argType ArgType
 
@@ -138,9 +192,11 @@ type FuncCallArgPayload struct {
 
 	origVal  ast.Expr
 	cloneVal ast.Expr
+
+	isChan bool
 }
 
-func newFuncCallArgPayload(orig, clone *ast.CallExpr) ArgPayload {
+func newFuncCallArgPayload(orig, clone *ast.CallExpr, pass *analysis.Pass) ArgPayload {
 	funcName, ok := builtinFuncName(orig)
 	if !ok {
 		return nil
@@ -166,6 +222,7 @@ func newFuncCallArgPayload(orig, clone *ast.CallExpr) ArgPayload {
 		cloneFunc: clone,
 		origVal:   orig.Args[0],
 		cloneVal:  clone.Args[0],
+		isChan:    isChanExpr(orig.Args[0], pass),
 	}
 }
 
@@ -173,6 +230,26 @@ func (f *FuncCallArgPayload) ArgType() ArgType {
 	return f.argType
 }
 
+// IsChan returns true if the argument of the len() or the cap() function is a channel
+func (f *FuncCallArgPayload) IsChan() bool {
+	return f.isChan
+}
+
+// GetOrigArg returns the argument of the len() or the cap() function, from the original expression
+func (f *FuncCallArgPayload) GetOrigArg() ast.Expr {
+	return f.origVal
+}
+
+func isChanExpr(expr ast.Expr, pass *analysis.Pass) bool {
+	t := pass.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return false
+	}
+
+	_, ok := t.Underlying().(*gotypes.Chan)
+	return ok
+}
+
 type ErrPayload struct {
 	value.Valuer
 }
@@ -195,6 +272,10 @@ func (ErrorMethodPayload) ArgType() ArgType {
 
 func parseBinaryExpr(origActualExpr, argExprClone *ast.BinaryExpr, pass *analysis.Pass) ArgPayload {
 	left, right, op := origActualExpr.X, origActualExpr.Y, origActualExpr.Op
+	if op == token.LOR {
+		return newElementOfPayload(origActualExpr, argExprClone, pass)
+	}
+
 	replace := false
 	switch realFirst := left.(type) {
 	case *ast.Ident: // check if const
@@ -243,5 +324,5 @@ func parseBinaryExpr(origActualExpr, argExprClone *ast.BinaryExpr, pass *analysi
 		}
 	}
 
-	return newComparisonArgPayload(leftVal, rightVal, op)
+	return newComparisonArgPayload(leftVal, rightVal, op, getIncomparableType(op, left, right, pass))
 }
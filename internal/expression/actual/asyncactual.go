@@ -10,26 +10,33 @@ import (
 )
 
 type AsyncArg struct {
-	valid bool
-	fun   *ast.CallExpr
+	valid      bool
+	fun        *ast.CallExpr
+	fixedValue bool
 
 	timeoutInterval intervals.DurationValue
 	pollingInterval intervals.DurationValue
 	tooManyTimeouts bool
 	tooManyPolling  bool
+	blockingCall    string
+	discardedErr    *ast.CallExpr
+	channelRecv     *ast.UnaryExpr
 }
 
 func newAsyncArg(origExpr, cloneExpr, orig, clone *ast.CallExpr, argType gotypes.Type, pass *analysis.Pass, actualOffset int, timePkg string) *AsyncArg {
 	var (
-		fun     *ast.CallExpr
-		valid   = true
-		timeout intervals.DurationValue
-		polling intervals.DurationValue
+		fun        *ast.CallExpr
+		valid      = true
+		fixedValue = false
+		timeout    intervals.DurationValue
+		polling    intervals.DurationValue
 	)
 
 	if _, isActualFuncCall := orig.Args[actualOffset].(*ast.CallExpr); isActualFuncCall {
 		fun = clone.Args[actualOffset].(*ast.CallExpr)
 		valid = isValidAsyncValueType(argType)
+	} else if argType != nil {
+		fixedValue = isFixedAsyncValueType(argType)
 	}
 
 	timeoutOffset := actualOffset + 1
@@ -83,10 +90,14 @@ func newAsyncArg(origExpr, cloneExpr, orig, clone *ast.CallExpr, argType gotypes
 	return &AsyncArg{
 		valid:           valid,
 		fun:             fun,
+		fixedValue:      fixedValue,
 		timeoutInterval: timeout,
 		pollingInterval: polling,
 		tooManyTimeouts: tooManyTimeouts,
 		tooManyPolling:  tooManyPolling,
+		blockingCall:    getBlockingCall(orig.Args[actualOffset], pass),
+		discardedErr:    getDiscardedErrorCall(orig.Args[actualOffset], pass),
+		channelRecv:     getChannelReceive(orig.Args[actualOffset]),
 	}
 }
 
@@ -94,6 +105,12 @@ func (a *AsyncArg) IsValid() bool {
 	return a.valid
 }
 
+// IsFixedValue returns true if the actual argument is not a function call, and its value can never
+// change while polling; e.g. `Eventually(x)`, when x is an int
+func (a *AsyncArg) IsFixedValue() bool {
+	return a.fixedValue
+}
+
 func (a *AsyncArg) Timeout() intervals.DurationValue {
 	return a.timeoutInterval
 }
@@ -110,6 +127,23 @@ func (a *AsyncArg) TooManyPolling() bool {
 	return a.tooManyPolling
 }
 
+// GetBlockingCall returns a description of the blocking operation, if the actual argument is a
+// function literal, that only runs a known blocking operation; e.g. `func() int { return <-ch }`
+func (a *AsyncArg) GetBlockingCall() (string, bool) {
+	return a.blockingCall, a.blockingCall != ""
+}
+
+// GetDiscardedErrorCall returns the first call in the actual function literal, that its error
+// result is assigned to the blank identifier; e.g. `v, _ := f()`
+func (a *AsyncArg) GetDiscardedErrorCall() (*ast.CallExpr, bool) {
+	return a.discardedErr, a.discardedErr != nil
+}
+
+// GetChannelReceive returns the first channel receive in the actual function literal; e.g. `<-ch`
+func (a *AsyncArg) GetChannelReceive() (*ast.UnaryExpr, bool) {
+	return a.channelRecv, a.channelRecv != nil
+}
+
 func isValidAsyncValueType(t gotypes.Type) bool {
 	switch t.(type) {
 	// allow functions that return function or channel.
@@ -121,3 +155,17 @@ func isValidAsyncValueType(t gotypes.Type) bool {
 
 	return false
 }
+
+// isFixedAsyncValueType checks if a value of this type is copied as is into the async assertion,
+// so the assertion will poll the same value over and over. Functions, channels, pointers, maps,
+// slices and interfaces are not reported, because the data they refer to may change.
+func isFixedAsyncValueType(t gotypes.Type) bool {
+	switch tt := t.Underlying().(type) {
+	case *gotypes.Basic:
+		return tt.Kind() != gotypes.UntypedNil && tt.Kind() != gotypes.UnsafePointer
+	case *gotypes.Struct, *gotypes.Array:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package actual
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// getBlockingCall returns a description of the blocking operation, if the async actual argument is
+// a function literal, that its only statement is a known blocking operation; i.e. a channel
+// receive, a `Wait()` call of a sync.WaitGroup, or a time.Sleep call; e.g.
+//
+//	Consistently(func() int { return <-ch }).Should(Equal(1))
+func getBlockingCall(orig ast.Expr, pass *analysis.Pass) string {
+	funcLit, ok := ast.Unparen(orig).(*ast.FuncLit)
+	if !ok || funcLit.Body == nil || len(funcLit.Body.List) != 1 {
+		return ""
+	}
+
+	var expr ast.Expr
+	switch s := funcLit.Body.List[0].(type) {
+	case *ast.ExprStmt:
+		expr = s.X
+	case *ast.ReturnStmt:
+		if len(s.Results) != 1 {
+			return ""
+		}
+		expr = s.Results[0]
+	default:
+		return ""
+	}
+
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.UnaryExpr:
+		if e.Op == token.ARROW {
+			return "a channel receive"
+		}
+
+	case *ast.CallExpr:
+		if funccall.IsMethodOf(pass, e, "sync", "WaitGroup", "Wait") {
+			return "sync.WaitGroup.Wait()"
+		}
+
+		if funccall.IsPkgFunc(pass, e, "time", "Sleep") {
+			return "time.Sleep()"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,29 @@
+package actual
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// getBufferBytesReceiver returns the receiver, from the clone, if the actual argument is a call to
+// the Bytes() method of bytes.Buffer; e.g. the `buf` in `Expect(buf.Bytes())`
+func getBufferBytesReceiver(orig, clone ast.Expr, pass *analysis.Pass) ast.Expr {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok || !funccall.IsMethodOf(pass, call, "bytes", "Buffer", "Bytes") {
+		return nil
+	}
+
+	if _, ok := call.Fun.(*ast.SelectorExpr); !ok {
+		return nil
+	}
+
+	cloneCall, ok := ast.Unparen(clone).(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	return cloneCall.Fun.(*ast.SelectorExpr).X
+}
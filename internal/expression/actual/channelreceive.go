@@ -0,0 +1,40 @@
+package actual
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// getChannelReceive returns the first channel receive in the async actual function literal; e.g.
+//
+//	Consistently(func() int { return <-ch }).Should(Equal(1))
+//
+// Nested function literals are not checked.
+func getChannelReceive(orig ast.Expr) *ast.UnaryExpr {
+	funcLit, ok := ast.Unparen(orig).(*ast.FuncLit)
+	if !ok || funcLit.Body == nil {
+		return nil
+	}
+
+	var found *ast.UnaryExpr
+	ast.Inspect(funcLit.Body, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.UnaryExpr:
+			if node.Op == token.ARROW {
+				found = node
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
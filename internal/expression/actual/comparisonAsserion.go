@@ -142,16 +142,18 @@ func (f *FuncComparisonPayload) GetFuncArg() ast.Expr {
 }
 
 type ComparisonArgPayload struct {
-	left  value.Valuer
-	right value.Valuer
-	op    token.Token
+	left         value.Valuer
+	right        value.Valuer
+	op           token.Token
+	incomparable gotypes.Type
 }
 
-func newComparisonArgPayload(left, right value.Valuer, op token.Token) *ComparisonArgPayload {
+func newComparisonArgPayload(left, right value.Valuer, op token.Token, incomparable gotypes.Type) *ComparisonArgPayload {
 	return &ComparisonArgPayload{
-		left:  left,
-		right: right,
-		op:    op,
+		left:         left,
+		right:        right,
+		op:           op,
+		incomparable: incomparable,
 	}
 }
 
@@ -171,6 +173,51 @@ func (c *ComparisonArgPayload) GetRight() value.Valuer {
 	return c.right
 }
 
+// GetIncomparableType returns the type of the compared values, if it is not comparable; e.g. when
+// comparing two slices that are converted to interfaces, like `any(s1) == any(s2)`. Such a
+// comparison panics at runtime.
+func (c *ComparisonArgPayload) GetIncomparableType() (gotypes.Type, bool) {
+	return c.incomparable, c.incomparable != nil
+}
+
+// getIncomparableType returns the type of the operands of an equality comparison, if both operands
+// are of the same type, and this type is not comparable. For an operand that is converted to an
+// interface, like `any(s)`, the type of the converted value is used, because this is the dynamic
+// type that is compared at runtime.
+func getIncomparableType(op token.Token, left, right ast.Expr, pass *analysis.Pass) gotypes.Type {
+	if op != token.EQL && op != token.NEQ {
+		return nil
+	}
+
+	leftType := getComparedType(left, pass)
+	rightType := getComparedType(right, pass)
+	if leftType == nil || rightType == nil || !gotypes.Identical(leftType, rightType) || gotypes.Comparable(leftType) {
+		return nil
+	}
+
+	return leftType
+}
+
+// getComparedType returns the type of the value that is compared at runtime, or nil if it is not
+// known; i.e. for an interface value, with an unknown dynamic type
+func getComparedType(operand ast.Expr, pass *analysis.Pass) gotypes.Type {
+	t := pass.TypesInfo.TypeOf(operand)
+	if t == nil || !gotypes.IsInterface(t) {
+		return t
+	}
+
+	conv, ok := ast.Unparen(operand).(*ast.CallExpr)
+	if !ok || len(conv.Args) != 1 || !pass.TypesInfo.Types[conv.Fun].IsType() {
+		return nil
+	}
+
+	if t = pass.TypesInfo.TypeOf(conv.Args[0]); t == nil || gotypes.IsInterface(t) {
+		return nil
+	}
+
+	return t
+}
+
 type NilComparisonPayload struct {
 	val   value.Valuer
 	right value.Valuer
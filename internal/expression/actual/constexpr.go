@@ -0,0 +1,26 @@
+package actual
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression/value"
+)
+
+// isConstantCalc checks if the actual argument is an arithmetic expression of constants, that is
+// computed at compile time; e.g. `Expect(2 + 2)`. Comparisons, like `2 == 2`, are not included.
+func isConstantCalc(orig, clone ast.Expr, pass *analysis.Pass) bool {
+	bin, ok := ast.Unparen(orig).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+
+	switch bin.Op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ, token.LAND, token.LOR:
+		return false
+	}
+
+	return value.New(orig, clone, pass).GetValue() != nil
+}
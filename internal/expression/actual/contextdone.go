@@ -0,0 +1,30 @@
+package actual
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// isContextDone checks if the actual argument is a call of the Done method of the context.Context
+// interface, or of a type that embeds it; e.g. `Expect(ctx.Done())`
+func isContextDone(orig ast.Expr, pass *analysis.Pass) bool {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != gotypes.MethodVal {
+		return false
+	}
+
+	fn, ok := selection.Obj().(*gotypes.Func)
+	return ok && fn.Name() == "Done" && fn.Pkg() != nil && fn.Pkg().Path() == "context"
+}
@@ -0,0 +1,27 @@
+package actual
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// getRedundantConversionOperand returns the converted value (from the clone), if the actual
+// argument is a conversion of a value to its own type; e.g. `Expect(int(x))`, when x is already an
+// int. This is not an actual argument payload, so the other rules still see the converted value;
+// e.g. a pointer conversion is still a pointer.
+func getRedundantConversionOperand(orig, clone ast.Expr, pass *analysis.Pass) ast.Expr {
+	origCall, ok := orig.(*ast.CallExpr)
+	if !ok || !funccall.IsRedundantConversion(pass, origCall) {
+		return nil
+	}
+
+	cloneCall, ok := clone.(*ast.CallExpr)
+	if !ok || len(cloneCall.Args) != 1 {
+		return nil
+	}
+
+	return cloneCall.Args[0]
+}
@@ -0,0 +1,75 @@
+package actual
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
+)
+
+// getDiscardedErrorCall returns the first function call in the async actual function literal, that
+// its error result is assigned to the blank identifier; e.g.
+//
+//	Eventually(func() int { v, _ := f(); return v }).Should(Equal(1))
+//
+// Nested function literals are not checked.
+func getDiscardedErrorCall(orig ast.Expr, pass *analysis.Pass) *ast.CallExpr {
+	funcLit, ok := ast.Unparen(orig).(*ast.FuncLit)
+	if !ok || funcLit.Body == nil {
+		return nil
+	}
+
+	var found *ast.CallExpr
+	ast.Inspect(funcLit.Body, func(n ast.Node) bool {
+		if found != nil {
+			return false
+		}
+
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.AssignStmt:
+			if len(node.Rhs) != 1 {
+				return true
+			}
+
+			call, ok := ast.Unparen(node.Rhs[0]).(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			for i, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name == "_" && isErrorResult(pass, call, i, len(node.Lhs)) {
+					found = call
+					return false
+				}
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// isErrorResult checks if the i-th result of the call, out of numResults, is an error
+func isErrorResult(pass *analysis.Pass, call *ast.CallExpr, i, numResults int) bool {
+	t := pass.TypesInfo.TypeOf(call)
+	if t == nil {
+		return false
+	}
+
+	if tuple, ok := t.(*gotypes.Tuple); ok {
+		if tuple.Len() != numResults {
+			return false
+		}
+		t = tuple.At(i).Type()
+	} else if numResults != 1 {
+		return false
+	}
+
+	return interfaces.ImplementsError(t)
+}
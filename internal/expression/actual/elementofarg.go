@@ -0,0 +1,167 @@
+package actual
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// ElementOfPayload is an actual argument that is a chain of equality comparisons of the same
+// value, connected with the `||` operator; e.g. `x == a || x == b || x == c`
+type ElementOfPayload struct {
+	value    ast.Expr
+	elements []ast.Expr
+}
+
+func newElementOfPayload(orig, clone *ast.BinaryExpr, pass *analysis.Pass) ArgPayload {
+	origComparisons, ok := flattenOrChain(orig, nil)
+	if !ok || len(origComparisons) < 2 {
+		return nil
+	}
+
+	cloneComparisons, ok := flattenOrChain(clone, nil)
+	if !ok || len(cloneComparisons) != len(origComparisons) {
+		return nil
+	}
+
+	// the compared value may be on either side of the first comparison
+	first, firstClone := origComparisons[0], cloneComparisons[0]
+	for _, operands := range [][2]ast.Expr{{first.X, firstClone.X}, {first.Y, firstClone.Y}} {
+		if elements, ok := getElements(operands[0], origComparisons, cloneComparisons, pass); ok {
+			return &ElementOfPayload{
+				value:    operands[1],
+				elements: elements,
+			}
+		}
+	}
+
+	return nil
+}
+
+// getElements returns the other side of each of the comparisons (from the clone), if all the
+// comparisons compare the operand, to an element of the same type
+func getElements(operand ast.Expr, origComparisons, cloneComparisons []*ast.BinaryExpr, pass *analysis.Pass) ([]ast.Expr, bool) {
+	operandType := pass.TypesInfo.TypeOf(operand)
+	if operandType == nil || !isSimpleOperand(operand) {
+		return nil, false
+	}
+
+	operandStr := gotypes.ExprString(operand)
+	elements := make([]ast.Expr, 0, len(origComparisons))
+	for i, cmp := range origComparisons {
+		var elem, elemClone ast.Expr
+		switch operandStr {
+		case gotypes.ExprString(cmp.X):
+			elem, elemClone = cmp.Y, cloneComparisons[i].Y
+		case gotypes.ExprString(cmp.Y):
+			elem, elemClone = cmp.X, cloneComparisons[i].X
+		default:
+			return nil, false
+		}
+
+		if !gotypes.Identical(getElementType(elem, pass), operandType) {
+			return nil, false
+		}
+
+		elements = append(elements, elemClone)
+	}
+
+	return elements, true
+}
+
+func (*ElementOfPayload) ArgType() ArgType {
+	return ElementOfArgType
+}
+
+// GetValue returns the compared value, from the expression clone
+func (p *ElementOfPayload) GetValue() ast.Expr {
+	return p.value
+}
+
+// GetElements returns the values that the value is compared to, from the expression clone
+func (p *ElementOfPayload) GetElements() []ast.Expr {
+	return p.elements
+}
+
+// flattenOrChain returns the list of the `==` comparisons of a `||` chain, or false if the chain
+// contains any other kind of expression
+func flattenOrChain(expr ast.Expr, comparisons []*ast.BinaryExpr) ([]*ast.BinaryExpr, bool) {
+	bin, ok := ast.Unparen(expr).(*ast.BinaryExpr)
+	if !ok {
+		return nil, false
+	}
+
+	switch bin.Op {
+	case token.LOR:
+		comparisons, ok = flattenOrChain(bin.X, comparisons)
+		if !ok {
+			return nil, false
+		}
+		return flattenOrChain(bin.Y, comparisons)
+
+	case token.EQL:
+		return append(comparisons, bin), true
+	}
+
+	return nil, false
+}
+
+// isSimpleOperand checks that the compared value has no side effects, so it can be evaluated only
+// once, instead of once per comparison
+func isSimpleOperand(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name != "nil"
+	case *ast.SelectorExpr:
+		return isSimpleOperand(e.X)
+	}
+
+	return false
+}
+
+// getElementType returns the type that the element will have, when passed to a matcher as an
+// interface{} value. Untyped constants get their default type.
+func getElementType(expr ast.Expr, pass *analysis.Pass) gotypes.Type {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		// the type info of a literal is already converted to the type of the other operand, so
+		// use the kind of the literal token; e.g. 1 is an int, even if compared to a float64
+		return basicLitDefaultType(e.Kind)
+
+	case *ast.Ident:
+		if c, ok := pass.TypesInfo.Uses[e].(*gotypes.Const); ok {
+			return gotypes.Default(c.Type())
+		}
+
+	case *ast.SelectorExpr:
+		if c, ok := pass.TypesInfo.Uses[e.Sel].(*gotypes.Const); ok {
+			return gotypes.Default(c.Type())
+		}
+	}
+
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value != nil || tv.IsNil() {
+		return nil
+	}
+
+	return tv.Type
+}
+
+func basicLitDefaultType(kind token.Token) gotypes.Type {
+	switch kind {
+	case token.INT:
+		return gotypes.Typ[gotypes.Int]
+	case token.FLOAT:
+		return gotypes.Typ[gotypes.Float64]
+	case token.IMAG:
+		return gotypes.Typ[gotypes.Complex128]
+	case token.CHAR:
+		return gotypes.Typ[gotypes.Rune]
+	case token.STRING:
+		return gotypes.Typ[gotypes.String]
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package actual
+
+import (
+	"go/ast"
+	"go/constant"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
+)
+
+// ErrorStringKind describes how the actual argument converts an error to a string
+type ErrorStringKind int
+
+const (
+	NoErrorString ErrorStringKind = iota
+	// ErrorMethodString is a call of the Error() method; e.g. `err.Error()`
+	ErrorMethodString
+	// SprintfErrorString is a fmt.Sprintf call with the "%v" or the "%s" format; e.g.
+	// `fmt.Sprintf("%v", err)`
+	SprintfErrorString
+)
+
+// getErrorString returns the error, from the clone, if the actual argument converts an error to a
+// string; i.e. a call to the Error() method of a value that implements the error interface, like
+// the `err` in `Expect(err.Error())`, or a fmt.Sprintf call of the error, with the "%v" or the "%s"
+// format, like the `err` in `Expect(fmt.Sprintf("%v", err))`
+//
+// The static type of the error must implement the error interface, so a type with an Error()
+// method of another signature is not considered as an error.
+func getErrorString(orig, clone ast.Expr, pass *analysis.Pass) (ast.Expr, ErrorStringKind) {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok {
+		return nil, NoErrorString
+	}
+
+	cloneCall, ok := ast.Unparen(clone).(*ast.CallExpr)
+	if !ok {
+		return nil, NoErrorString
+	}
+
+	if funccall.IsPkgFunc(pass, call, "fmt", "Sprintf") {
+		if len(call.Args) != 2 || call.Ellipsis.IsValid() || !isErrorFormat(call.Args[0], pass) || !isError(call.Args[1], pass) {
+			return nil, NoErrorString
+		}
+
+		return cloneCall.Args[1], SprintfErrorString
+	}
+
+	if len(call.Args) != 0 {
+		return nil, NoErrorString
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" {
+		return nil, NoErrorString
+	}
+
+	if selection, ok := pass.TypesInfo.Selections[sel]; !ok || selection.Recv() == nil {
+		return nil, NoErrorString
+	}
+
+	if !isError(sel.X, pass) {
+		return nil, NoErrorString
+	}
+
+	return ast.Unparen(cloneCall.Fun.(*ast.SelectorExpr).X), ErrorMethodString
+}
+
+func isError(expr ast.Expr, pass *analysis.Pass) bool {
+	t := pass.TypesInfo.TypeOf(expr)
+	return t != nil && interfaces.ImplementsError(t)
+}
+
+// isErrorFormat checks if the expression is the "%v" or the "%s" constant string
+func isErrorFormat(expr ast.Expr, pass *analysis.Pass) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return false
+	}
+
+	format := constant.StringVal(tv.Value)
+	return format == "%v" || format == "%s"
+}
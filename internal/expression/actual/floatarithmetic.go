@@ -0,0 +1,53 @@
+package actual
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+var arithmeticOps = map[token.Token]bool{
+	token.ADD: true,
+	token.SUB: true,
+	token.MUL: true,
+	token.QUO: true,
+}
+
+// hasFloatArithmetic checks if the actual argument includes an arithmetic operation of float
+// values; e.g. `Expect(a / b)`, when a and b are floats. Function literals and constant expressions
+// are not checked.
+func hasFloatArithmetic(orig ast.Expr, pass *analysis.Pass) bool {
+	found := false
+	ast.Inspect(orig, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		switch e := n.(type) {
+		case *ast.FuncLit:
+			return false
+
+		case *ast.BinaryExpr:
+			if !arithmeticOps[e.Op] {
+				return true
+			}
+
+			// constant expressions are computed at compile time, with an arbitrary precision
+			tv, ok := pass.TypesInfo.Types[e]
+			if !ok || tv.Value != nil {
+				return true
+			}
+
+			if basic, ok := tv.Type.Underlying().(*gotypes.Basic); ok && basic.Info()&gotypes.IsFloat != 0 {
+				found = true
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
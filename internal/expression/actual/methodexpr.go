@@ -0,0 +1,20 @@
+package actual
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// isMethodExpression checks if the actual argument is a method expression, like `T.Method` or
+// `(*T).Method`; i.e. an unbound method, that receives the receiver as its first parameter
+func isMethodExpression(orig ast.Expr, pass *analysis.Pass) bool {
+	sel, ok := ast.Unparen(orig).(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[sel]
+	return ok && selection.Kind() == gotypes.MethodExpr
+}
@@ -0,0 +1,95 @@
+package actual
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// pkgFuncArgTypes maps known functions from other packages (package path => function name) to their arg type
+var pkgFuncArgTypes = map[string]map[string]ArgType{
+	"errors": {
+		"Is": ErrorsIsArgType,
+		"As": ErrorsAsArgType,
+	},
+	"os": {
+		"IsExist":      OsIsErrorArgType,
+		"IsNotExist":   OsIsErrorArgType,
+		"IsPermission": OsIsErrorArgType,
+	},
+	"reflect": {
+		"DeepEqual": DeepEqualArgType,
+	},
+	"strconv": {
+		"FormatInt": StrconvFormatArgType,
+		"Itoa":      StrconvFormatArgType,
+	},
+	"strings": {
+		"Contains":  StringsFuncArgType,
+		"HasPrefix": StringsFuncArgType,
+		"HasSuffix": StringsFuncArgType,
+	},
+	"unicode/utf8": {
+		"RuneCount":         RuneCountArgType,
+		"RuneCountInString": RuneCountArgType,
+	},
+}
+
+// PkgFuncCallPayload is an actual argument that is a call to a known function from another package; e.g. errors.Is
+type PkgFuncCallPayload struct {
+	argType  ArgType
+	funcName string
+	fun      ast.Expr
+
+	origArgs  []ast.Expr
+	cloneArgs []ast.Expr
+}
+
+func newPkgFuncCallPayload(orig, clone *ast.CallExpr, pass *analysis.Pass) ArgPayload {
+	pkgPath, funcName, ok := funccall.GetPkgFunc(pass, orig)
+	if !ok {
+		return nil
+	}
+
+	argType, ok := pkgFuncArgTypes[pkgPath][funcName]
+	if !ok {
+		return nil
+	}
+
+	return &PkgFuncCallPayload{
+		argType:   argType,
+		funcName:  funcName,
+		fun:       clone.Fun,
+		origArgs:  orig.Args,
+		cloneArgs: clone.Args,
+	}
+}
+
+func (p *PkgFuncCallPayload) ArgType() ArgType {
+	return p.argType
+}
+
+func (p *PkgFuncCallPayload) FuncName() string {
+	return p.funcName
+}
+
+// GetFunc returns the called function, from the expression clone; e.g. `errors.Is`
+func (p *PkgFuncCallPayload) GetFunc() ast.Expr {
+	return p.fun
+}
+
+func (p *PkgFuncCallPayload) NumArgs() int {
+	return len(p.origArgs)
+}
+
+// GetOrigArg returns the original i-th argument of the function call
+func (p *PkgFuncCallPayload) GetOrigArg(i int) ast.Expr {
+	return p.origArgs[i]
+}
+
+// GetArg returns the i-th argument of the function call, from the expression clone
+func (p *PkgFuncCallPayload) GetArg(i int) ast.Expr {
+	return p.cloneArgs[i]
+}
@@ -0,0 +1,82 @@
+package actual
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// RegexpMatchPayload is an actual argument that is a call to the regexp.MatchString function, or
+// to the MatchString method of a regular expression, that is compiled in place by
+// regexp.MustCompile; e.g. `regexp.MatchString(p, s)` or `regexp.MustCompile(p).MatchString(s)`
+type RegexpMatchPayload struct {
+	pattern  ast.Expr
+	subject  ast.Expr
+	funcName string
+}
+
+// newRegexpMatchPayload returns the regexp payload, if the call is a regexp matching call; or nil
+// otherwise
+func newRegexpMatchPayload(orig, clone *ast.CallExpr, pass *analysis.Pass) ArgPayload {
+	if funccall.IsPkgFunc(pass, orig, "regexp", "MatchString") {
+		if len(orig.Args) != 2 {
+			return nil
+		}
+
+		return &RegexpMatchPayload{
+			pattern:  clone.Args[0],
+			subject:  clone.Args[1],
+			funcName: "regexp.MatchString",
+		}
+	}
+
+	if len(orig.Args) != 1 || !funccall.IsMethodOf(pass, orig, "regexp", "Regexp", "MatchString") {
+		return nil
+	}
+
+	sel, ok := orig.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	compile, ok := ast.Unparen(sel.X).(*ast.CallExpr)
+	if !ok || len(compile.Args) != 1 || !funccall.IsPkgFunc(pass, compile, "regexp", "MustCompile") {
+		return nil
+	}
+
+	cloneCompile := ast.Unparen(clone.Fun.(*ast.SelectorExpr).X).(*ast.CallExpr)
+
+	return &RegexpMatchPayload{
+		pattern:  cloneCompile.Args[0],
+		subject:  clone.Args[0],
+		funcName: "regexp.MustCompile(...).MatchString",
+	}
+}
+
+func (*RegexpMatchPayload) ArgType() ArgType {
+	return RegexpMatchArgType
+}
+
+// GetPattern returns the regular expression pattern, from the expression clone
+func (p *RegexpMatchPayload) GetPattern() ast.Expr {
+	return p.pattern
+}
+
+// GetSubject returns the matched string, from the expression clone
+func (p *RegexpMatchPayload) GetSubject() ast.Expr {
+	return p.subject
+}
+
+// FuncName returns a short description of the matching call, for the report message
+func (p *RegexpMatchPayload) FuncName() string {
+	return p.funcName
+}
+
+// GetRegexpMatch returns the regexp payload of a call expression that is not a gomega actual
+// argument; e.g. the right side of an assignment
+func GetRegexpMatch(call *ast.CallExpr, pass *analysis.Pass) (*RegexpMatchPayload, bool) {
+	p, ok := newRegexpMatchPayload(call, call, pass).(*RegexpMatchPayload)
+	return p, ok
+}
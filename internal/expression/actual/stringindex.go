@@ -0,0 +1,35 @@
+package actual
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// StringIndexPayload is an actual argument that is an index expression of a string, that returns a
+// byte; e.g. `s[0]`
+type StringIndexPayload struct {
+	RegularArgPayload
+}
+
+// newStringIndexPayload returns the string index payload, if the expression is an index of a
+// string value; or nil otherwise
+func newStringIndexPayload(orig *ast.IndexExpr, clone ast.Expr, pass *analysis.Pass) ArgPayload {
+	t := pass.TypesInfo.TypeOf(orig.X)
+	if t == nil {
+		return nil
+	}
+
+	if basic, ok := t.Underlying().(*gotypes.Basic); !ok || basic.Info()&gotypes.IsString == 0 {
+		return nil
+	}
+
+	return &StringIndexPayload{
+		RegularArgPayload: *newRegularArgPayload(orig, clone, pass),
+	}
+}
+
+func (*StringIndexPayload) ArgType() ArgType {
+	return StringIndexArgType
+}
@@ -0,0 +1,29 @@
+package actual
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// syncMapLoadMethods are the methods of sync.Map, that return a value and a boolean
+var syncMapLoadMethods = []string{"Load", "LoadOrStore", "LoadAndDelete"}
+
+// getSyncMapLoadMethod returns the name of the method, if the actual argument is a call to a
+// sync.Map method, that returns a value and a boolean; e.g. `Load` for `Expect(m.Load(key))`
+func getSyncMapLoadMethod(orig ast.Expr, pass *analysis.Pass) string {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+
+	for _, name := range syncMapLoadMethods {
+		if funccall.IsMethodOf(pass, call, "sync", "Map", name) {
+			return name
+		}
+	}
+
+	return ""
+}
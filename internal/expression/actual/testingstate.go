@@ -0,0 +1,68 @@
+package actual
+
+import (
+	"go/ast"
+	gotypes "go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// testingStateMethods are the methods of the testing framework, that return the state of the test
+var testingStateMethods = map[string]bool{
+	"Failed":  true,
+	"Skipped": true,
+}
+
+// testingTypes are the testing framework types, by their package path
+var testingTypes = map[string][]string{
+	"testing":                   {"T", "B", "F", "TB"},
+	"github.com/onsi/ginkgo/v2": {"GinkgoTInterface", "FullGinkgoTInterface"},
+}
+
+// getTestingStateMethod returns the method name, if the actual argument is a call of a method, that
+// returns the state of the test, of *testing.T, testing.TB or of the ginkgo T interfaces; e.g.
+// `Expect(t.Failed())`. The method of an interface is not a static call, so the receiver type is
+// taken from the selection, and not from the called function.
+func getTestingStateMethod(orig ast.Expr, pass *analysis.Pass) string {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !testingStateMethods[sel.Sel.Name] {
+		return ""
+	}
+
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != gotypes.MethodVal {
+		return ""
+	}
+
+	recv := selection.Recv()
+	if ptr, ok := recv.(*gotypes.Pointer); ok {
+		recv = ptr.Elem()
+	}
+
+	named, ok := gotypes.Unalias(recv).(*gotypes.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return ""
+	}
+
+	pkgPath := named.Obj().Pkg().Path()
+	for path, names := range testingTypes {
+		// use HasSuffix, to support vendored packages
+		if pkgPath != path && !strings.HasSuffix(pkgPath, "/vendor/"+path) {
+			continue
+		}
+
+		for _, name := range names {
+			if name == named.Obj().Name() {
+				return sel.Sel.Name
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,75 @@
+package actual
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// timeCompareOperators maps the comparison methods of time.Time to the BeTemporally operators
+var timeCompareOperators = map[string]token.Token{
+	"After":  token.GTR,
+	"Before": token.LSS,
+	"Equal":  token.EQL,
+}
+
+// TimeComparePayload is an actual argument that is a call to the After, the Before or the Equal
+// method of a time.Time value; e.g. `t1.After(t2)`
+type TimeComparePayload struct {
+	receiver   ast.Expr
+	other      ast.Expr
+	methodName string
+	op         token.Token
+}
+
+// newTimeComparePayload returns the time comparison payload, if the call is a comparison method
+// call of a time.Time value; or nil otherwise
+func newTimeComparePayload(orig, clone *ast.CallExpr, pass *analysis.Pass) ArgPayload {
+	if len(orig.Args) != 1 {
+		return nil
+	}
+
+	sel, ok := orig.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	op, ok := timeCompareOperators[sel.Sel.Name]
+	if !ok || !funccall.IsMethodOf(pass, orig, "time", "Time", sel.Sel.Name) {
+		return nil
+	}
+
+	return &TimeComparePayload{
+		receiver:   clone.Fun.(*ast.SelectorExpr).X,
+		other:      clone.Args[0],
+		methodName: sel.Sel.Name,
+		op:         op,
+	}
+}
+
+func (*TimeComparePayload) ArgType() ArgType {
+	return TimeCompareArgType
+}
+
+// GetReceiver returns the time.Time value that the method is called on, from the expression clone
+func (p *TimeComparePayload) GetReceiver() ast.Expr {
+	return p.receiver
+}
+
+// GetOther returns the time.Time value that the receiver is compared to, from the expression clone
+func (p *TimeComparePayload) GetOther() ast.Expr {
+	return p.other
+}
+
+// MethodName returns the name of the comparison method; e.g. "After"
+func (p *TimeComparePayload) MethodName() string {
+	return p.methodName
+}
+
+// GetOp returns the BeTemporally operator that matches the comparison method
+func (p *TimeComparePayload) GetOp() token.Token {
+	return p.op
+}
@@ -0,0 +1,16 @@
+package actual
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// isTimeNow checks if the actual argument is a call of the time.Now function; e.g.
+// `Expect(time.Now())`
+func isTimeNow(orig ast.Expr, pass *analysis.Pass) bool {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	return ok && funccall.IsPkgFunc(pass, call, "time", "Now")
+}
@@ -0,0 +1,43 @@
+package actual
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
+)
+
+// getTransformCall returns the call, from the clone, if the actual argument is a call to a package
+// level function, that transforms a single value into another single value; e.g.
+// `strings.ToLower(s)`. Generic functions are not supported, because WithTransform can't infer
+// their type arguments.
+func getTransformCall(orig, clone ast.Expr, pass *analysis.Pass) *ast.CallExpr {
+	call, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || call.Ellipsis.IsValid() {
+		return nil
+	}
+
+	fn := typeutil.StaticCallee(pass.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil {
+		return nil
+	}
+
+	sig, ok := fn.Type().(*gotypes.Signature)
+	if !ok || sig.Recv() != nil || sig.TypeParams().Len() > 0 || sig.Variadic() {
+		return nil
+	}
+
+	if sig.Params().Len() != 1 || sig.Results().Len() != 1 || interfaces.ImplementsError(sig.Results().At(0).Type()) {
+		return nil
+	}
+
+	cloneCall, ok := ast.Unparen(clone).(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+
+	return cloneCall
+}
@@ -0,0 +1,84 @@
+package expression
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+)
+
+// getEnumConst returns the constant of the named integer type of the actual value, that has the
+// same value as the integer literal of the Equal matcher; e.g. `StatusActive`, for
+// `Expect(status).To(Equal(1))`, when status is of the Status type, and `StatusActive Status = 1`.
+// If there is no such constant, or there are several such constants, nil is returned.
+func getEnumConst(assertion *ast.CallExpr, actualType gotypes.Type, mtchr *matcher.Matcher, pass *analysis.Pass) ast.Expr {
+	named, ok := actualType.(*gotypes.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return nil
+	}
+
+	if basic, ok := named.Underlying().(*gotypes.Basic); !ok || basic.Info()&gotypes.IsInteger == 0 {
+		return nil
+	}
+
+	equal, ok := mtchr.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || equal.GetValue() == nil || len(mtchr.Orig.Args) != 1 {
+		return nil
+	}
+
+	if lit, ok := ast.Unparen(mtchr.Orig.Args[0]).(*ast.BasicLit); !ok || lit.Kind != token.INT {
+		return nil
+	}
+
+	pkg := named.Obj().Pkg()
+	samePkg := pkg == pass.Pkg
+
+	var found *gotypes.Const
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*gotypes.Const)
+		if !ok || !gotypes.Identical(c.Type(), named) || (!samePkg && !c.Exported()) {
+			continue
+		}
+
+		if constant.Compare(c.Val(), token.EQL, equal.GetValue()) {
+			if found != nil {
+				return nil
+			}
+			found = c
+		}
+	}
+
+	if found == nil {
+		return nil
+	}
+
+	if samePkg {
+		return ast.NewIdent(found.Name())
+	}
+
+	pkgName, ok := getImportName(assertion, pkg, pass)
+	if !ok {
+		return nil
+	}
+
+	return &ast.SelectorExpr{X: ast.NewIdent(pkgName), Sel: ast.NewIdent(found.Name())}
+}
+
+// getImportName returns the name of the package, as it is imported in the file of the assertion.
+// Dot imports are not supported.
+func getImportName(assertion *ast.CallExpr, pkg *gotypes.Package, pass *analysis.Pass) (string, bool) {
+	for scope := pass.Pkg.Scope().Innermost(assertion.Pos()); scope != nil && scope != pass.Pkg.Scope(); scope = scope.Parent() {
+		for _, name := range scope.Names() {
+			if pkgName, ok := scope.Lookup(name).(*gotypes.PkgName); ok && pkgName.Imported() == pkg && name != "." {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
 	"github.com/nunnatsa/ginkgolinter/internal/gomegainfo"
 	"github.com/nunnatsa/ginkgolinter/internal/reverseassertion"
+	"github.com/nunnatsa/ginkgolinter/types"
 )
 
 type GomegaExpression struct {
@@ -27,16 +28,20 @@ type GomegaExpression struct {
 	origAssertionFuncName string
 	actualFuncName        string
 
-	isAsync          bool
-	isUsingGomegaVar bool
+	isAsync           bool
+	isUsingGomegaVar  bool
+	hasAliasedMatcher bool
 
 	actual  *actual.Actual
 	matcher *matcher.Matcher
 
+	nestedAssertion *GomegaExpression
+	enumConst       ast.Expr
+
 	handler gomegahandler.Handler
 }
 
-func New(origExpr *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Handler, timePkg string) (*GomegaExpression, bool) {
+func New(origExpr *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Handler, timePkg string, aliases types.MatcherAliases) (*GomegaExpression, bool) {
 	info, ok := handler.GetGomegaBasicInfo(origExpr)
 	if !ok || !gomegainfo.IsActualMethod(info.MethodName) {
 		return nil, false
@@ -75,7 +80,7 @@ func New(origExpr *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Hand
 
 	matcherClone := exprClone.Args[0].(*ast.CallExpr)
 
-	mtchr, ok := matcher.New(origMatcher, matcherClone, pass, handler)
+	mtchr, ok := matcher.New(origMatcher, matcherClone, pass, handler, aliases)
 	if !ok {
 		return nil, false
 	}
@@ -90,8 +95,9 @@ func New(origExpr *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Hand
 		origAssertionFuncName: origSel.Sel.Name,
 		actualFuncName:        info.MethodName,
 
-		isAsync:          actl.IsAsync(),
-		isUsingGomegaVar: info.UseGomegaVar,
+		isAsync:           actl.IsAsync(),
+		isUsingGomegaVar:  info.UseGomegaVar,
+		hasAliasedMatcher: matcher.HasAliasedMatcher(origMatcher, aliases),
 
 		actual:  actl,
 		matcher: mtchr,
@@ -99,10 +105,20 @@ func New(origExpr *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Hand
 		handler: handler,
 	}
 
+	if !actl.IsAsync() {
+		gexp.enumConst = getEnumConst(origExpr, actl.ArgGOType(), mtchr, pass)
+	}
+
 	if mtchr.ShouldReverseLogic() {
 		gexp.ReverseAssertionFuncLogic()
 	}
 
+	if actualCall, ok := ast.Unparen(actl.GetOrigActualArg()).(*ast.CallExpr); ok {
+		if nested, ok := New(actualCall, pass, handler, timePkg, aliases); ok && !nested.IsMissingAssertion() {
+			gexp.nestedAssertion = nested
+		}
+	}
+
 	return gexp, true
 }
 
@@ -139,6 +155,13 @@ func (e *GomegaExpression) IsUsingGomegaVar() bool {
 	return e.isUsingGomegaVar
 }
 
+// HasAliasedMatcher returns true if the matcher, or any of its nested matchers, is a custom wrapper
+// matcher. The linter can't suggest a fix in this case, because it can't tell how to call the
+// gomega matcher from the wrapper location.
+func (e *GomegaExpression) HasAliasedMatcher() bool {
+	return e.hasAliasedMatcher
+}
+
 func (e *GomegaExpression) ReverseAssertionFuncLogic() {
 	assertionFunc := e.clone.Fun.(*ast.SelectorExpr).Sel
 	newName := reverseassertion.ChangeAssertionLogic(assertionFunc.Name)
@@ -148,6 +171,21 @@ func (e *GomegaExpression) ReverseAssertionFuncLogic() {
 
 func (e *GomegaExpression) ReplaceAssertionMethod(name string) {
 	e.clone.Fun.(*ast.SelectorExpr).Sel.Name = name
+	e.assertionFuncName = name
+}
+
+// ReplaceActualFuncName replaces the actual function, like `Expect` or `Ω`, using the gomega handler,
+// to support all the ways gomega is imported
+func (e *GomegaExpression) ReplaceActualFuncName(name string) {
+	e.handler.ReplaceFunction(e.GetActualClone(), ast.NewIdent(name))
+	e.actualFuncName = name
+}
+
+// ReplaceActualFuncWithOffset replaces an actual function with offset, like `ExpectWithOffset(1, x)`,
+// with the function without offset, and the WithOffset method; e.g. `Expect(x).WithOffset(1)`
+func (e *GomegaExpression) ReplaceActualFuncWithOffset(name string) {
+	e.ReplaceActualFuncName(name)
+	e.actual.MoveOffsetToMethod()
 }
 
 func (e *GomegaExpression) ReplaceMatcherFuncName(name string) {
@@ -242,6 +280,29 @@ func (e *GomegaExpression) SetMatcherHaveValue() {
 	e.matcher.Clone = newMatcherExp
 }
 
+// WrapMatcherWithMatchError wraps the matcher with the MatchError matcher, in place, so the Not()
+// wrappers of the matcher, if any, are kept; e.g. replace `ContainSubstring("x")` with
+// `MatchError(ContainSubstring("x"))`
+func (e *GomegaExpression) WrapMatcherWithMatchError() {
+	newMatcherExp := e.handler.GetNewWrapperMatcher("MatchError", astcopy.CallExpr(e.matcher.Clone))
+	*e.matcher.Clone = *newMatcherExp
+}
+
+// WrapMatcherWithTransform wraps the matcher with the WithTransform matcher, in place, so the Not()
+// wrappers of the matcher, if any, are kept; e.g. replace `Equal("abc")` with
+// `WithTransform(strings.ToLower, Equal("abc"))`
+func (e *GomegaExpression) WrapMatcherWithTransform(transform ast.Expr) {
+	newMatcherExp := e.handler.GetNewWrapperMatcher("WithTransform", astcopy.CallExpr(e.matcher.Clone))
+	newMatcherExp.Args = append([]ast.Expr{transform}, newMatcherExp.Args...)
+	*e.matcher.Clone = *newMatcherExp
+}
+
+// ReplaceMatcher replaces the matcher with a new matcher expression, in place, so the Not()
+// wrappers of the matcher, if any, are kept; e.g. replace `Equal(s)` with `HaveLen(3)`
+func (e *GomegaExpression) ReplaceMatcher(newMatcher *ast.CallExpr) {
+	*e.matcher.Clone = *astcopy.CallExpr(newMatcher)
+}
+
 func (e *GomegaExpression) SetMatcherEqual(arg ast.Expr) {
 	e.ReplaceMatcherFuncName("Equal")
 	e.ReplaceMatcherArgs([]ast.Expr{arg})
@@ -252,6 +313,28 @@ func (e *GomegaExpression) SetMatcherBeIdenticalTo(arg ast.Expr) {
 	e.ReplaceMatcherArgs([]ast.Expr{arg})
 }
 
+func (e *GomegaExpression) SetMatcherMatchError(arg ast.Expr) {
+	e.ReplaceMatcherFuncName("MatchError")
+	e.ReplaceMatcherArgs([]ast.Expr{arg})
+}
+
+// SetMatcherStringsFunc replaces the matcher with a string matcher with a single argument, like
+// `HavePrefix(arg)` or `ContainSubstring(arg)`
+func (e *GomegaExpression) SetMatcherStringsFunc(name string, arg ast.Expr) {
+	e.ReplaceMatcherFuncName(name)
+	e.ReplaceMatcherArgs([]ast.Expr{arg})
+}
+
+func (e *GomegaExpression) SetMatcherBeElementOf(args []ast.Expr) {
+	e.ReplaceMatcherFuncName("BeElementOf")
+	e.ReplaceMatcherArgs(args)
+}
+
+func (e *GomegaExpression) SetMatcherHaveExactElements(args []ast.Expr) {
+	e.ReplaceMatcherFuncName("HaveExactElements")
+	e.ReplaceMatcherArgs(args)
+}
+
 func (e *GomegaExpression) SetMatcherBeNumerically(op token.Token, arg ast.Expr) {
 	e.ReplaceMatcherFuncName("BeNumerically")
 	e.ReplaceMatcherArgs([]ast.Expr{
@@ -260,6 +343,16 @@ func (e *GomegaExpression) SetMatcherBeNumerically(op token.Token, arg ast.Expr)
 	})
 }
 
+// SetMatcherBeTemporally replaces the matcher with the BeTemporally matcher, with the operator and
+// the time value; e.g. `BeTemporally(">", t)`
+func (e *GomegaExpression) SetMatcherBeTemporally(op token.Token, arg ast.Expr) {
+	e.ReplaceMatcherFuncName("BeTemporally")
+	e.ReplaceMatcherArgs([]ast.Expr{
+		&ast.BasicLit{Kind: token.STRING, Value: fmt.Sprintf("%q", op.String())},
+		arg,
+	})
+}
+
 func (e *GomegaExpression) IsNegativeAssertion() bool {
 	return reverseassertion.IsNegativeLogic(e.assertionFuncName)
 }
@@ -290,6 +383,94 @@ func (e *GomegaExpression) GetActualArgExpr() ast.Expr {
 	return e.actual.GetActualArg()
 }
 
+func (e *GomegaExpression) GetOrigActualArgExpr() ast.Expr {
+	return e.actual.GetOrigActualArg()
+}
+
+// GetActualRedundantConversionOperand returns the converted value of the actual argument, if this
+// argument is a redundant type conversion; e.g. `Expect(int(x))`, when x is already an int
+func (e *GomegaExpression) GetActualRedundantConversionOperand() (ast.Expr, bool) {
+	return e.actual.GetRedundantConversionOperand()
+}
+
+// GetTestingStateMethod returns the name of the testing framework method, if the actual argument
+// is a call to a method that returns the state of the test; e.g. `Expect(t.Failed())`
+func (e *GomegaExpression) GetTestingStateMethod() (string, bool) {
+	return e.actual.GetTestingStateMethod()
+}
+
+// GetNestedAssertion returns the gomega assertion, if the actual argument is itself a complete
+// gomega assertion; e.g. `Expect(Expect(x).To(Equal(y))).To(BeTrue())`
+func (e *GomegaExpression) GetNestedAssertion() (*GomegaExpression, bool) {
+	return e.nestedAssertion, e.nestedAssertion != nil
+}
+
+// GetEnumConst returns the constant of the named integer type of the actual value, that has the
+// value of the integer literal of the Equal matcher; e.g. `StatusActive`, for
+// `Expect(status).To(Equal(1))`
+func (e *GomegaExpression) GetEnumConst() (ast.Expr, bool) {
+	return e.enumConst, e.enumConst != nil
+}
+
+// IsActualMethodExpression checks if the actual argument is an unbound method expression; e.g.
+// `Expect(T.Method)`
+func (e *GomegaExpression) IsActualMethodExpression() bool {
+	return e.actual.IsMethodExpression()
+}
+
+// HasActualFloatArithmetic checks if the actual argument includes an arithmetic operation of float
+// values; e.g. `Expect(a / b)`
+func (e *GomegaExpression) HasActualFloatArithmetic() bool {
+	return e.actual.HasFloatArithmetic()
+}
+
+// IsActualConstantCalc checks if the actual argument is an arithmetic expression of constants; e.g.
+// `Expect(2 + 2)`
+func (e *GomegaExpression) IsActualConstantCalc() bool {
+	return e.actual.IsConstantCalc()
+}
+
+// IsActualConstant checks if the actual argument is a constant; e.g. `Expect(42)`
+func (e *GomegaExpression) IsActualConstant() bool {
+	return e.actual.IsConstant()
+}
+
+// IsActualContextDone checks if the actual argument is a call of the Done method of a context;
+// e.g. `Expect(ctx.Done())`
+func (e *GomegaExpression) IsActualContextDone() bool {
+	return e.actual.IsContextDone()
+}
+
+// IsActualTimeNow checks if the actual argument is a call of the time.Now function; e.g.
+// `Expect(time.Now())`
+func (e *GomegaExpression) IsActualTimeNow() bool {
+	return e.actual.IsTimeNow()
+}
+
+// GetActualBufferBytesReceiver returns the bytes.Buffer receiver, if the actual argument is a call
+// to its Bytes() method; e.g. the `buf` in `Expect(buf.Bytes())`
+func (e *GomegaExpression) GetActualBufferBytesReceiver() (ast.Expr, bool) {
+	return e.actual.GetBufferBytesReceiver()
+}
+
+// GetActualTransformCall returns the call, if the actual argument is a call to a package level
+// function, that transforms a single value; e.g. `strings.ToLower(s)`
+func (e *GomegaExpression) GetActualTransformCall() (*ast.CallExpr, bool) {
+	return e.actual.GetTransformCall()
+}
+
+// GetActualSyncMapLoadMethod returns the method name, if the actual argument is a call to a
+// sync.Map method, that returns a value and a boolean; e.g. `m.Load(key)`
+func (e *GomegaExpression) GetActualSyncMapLoadMethod() (string, bool) {
+	return e.actual.GetSyncMapLoadMethod()
+}
+
+// GetActualErrorString returns the error, if the actual argument converts it to a string; e.g.
+// `Expect(err.Error())` or `Expect(fmt.Sprintf("%v", err))`
+func (e *GomegaExpression) GetActualErrorString() (ast.Expr, actual.ErrorStringKind) {
+	return e.actual.GetErrorString()
+}
+
 func (e *GomegaExpression) GetActualArgGOType() gotypes.Type {
 	return e.actual.ArgGOType()
 }
@@ -302,6 +483,10 @@ func (e *GomegaExpression) IsActualTuple() bool {
 	return e.actual.IsTuple()
 }
 
+func (e *GomegaExpression) IsActualTupleWithError() bool {
+	return e.actual.IsTupleWithError()
+}
+
 // Matcher proxies
 
 func (e *GomegaExpression) GetMatcher() *matcher.Matcher {
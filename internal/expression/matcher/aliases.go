@@ -0,0 +1,68 @@
+package matcher
+
+import (
+	"go/ast"
+
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+// getAliasedMatcherName returns the name of the gomega matcher, if the matcher function is a
+// custom wrapper matcher, that was configured as an alias; e.g. `MyEqual(x)` or `helpers.MyEqual(x)`
+func getAliasedMatcherName(expr *ast.CallExpr, aliases types.MatcherAliases) (string, bool) {
+	if len(aliases) == 0 {
+		return "", false
+	}
+
+	switch fun := expr.Fun.(type) {
+	case *ast.Ident:
+		return aliases.Get(fun.Name)
+	case *ast.SelectorExpr:
+		return aliases.Get(fun.Sel.Name)
+	}
+
+	return "", false
+}
+
+func replaceFuncName(call *ast.CallExpr, name *ast.Ident) {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		call.Fun = name
+	case *ast.SelectorExpr:
+		fun.Sel = name
+	}
+}
+
+// matcherNumArgs is the number of the arguments of the gomega matchers that are parsed by the
+// linter. Aliased wrapper matchers must receive the same arguments, but the linter should not
+// panic if they don't.
+var matcherNumArgs = map[string]int{
+	equal:          1,
+	haveLen:        1,
+	haveCap:        1,
+	beEquivalentTo: 1,
+	beIdenticalTo:  1,
+	matchError:     1,
+	haveValue:      1,
+	withTransform:  2,
+	containElement: 1,
+}
+
+// HasAliasedMatcher checks if the matcher expression, or any of its nested matchers, is an aliased
+// wrapper matcher
+func HasAliasedMatcher(expr ast.Expr, aliases types.MatcherAliases) bool {
+	if len(aliases) == 0 {
+		return false
+	}
+
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if _, ok := getAliasedMatcherName(call, aliases); ok {
+				found = true
+			}
+		}
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,45 @@
+package matcher
+
+import (
+	"go/ast"
+	"go/constant"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// getBytesConversion returns the string constant and its clone, if the expected value is a
+// conversion of a string constant to a byte slice type; e.g. `[]byte("abc")`, or
+// `json.RawMessage(`{"a": 1}`)`
+func getBytesConversion(orig, clone ast.Expr, pass *analysis.Pass) (constant.Value, ast.Expr) {
+	origCall, ok := ast.Unparen(orig).(*ast.CallExpr)
+	if !ok || len(origCall.Args) != 1 {
+		return nil, nil
+	}
+
+	cloneCall, ok := ast.Unparen(clone).(*ast.CallExpr)
+	if !ok || len(cloneCall.Args) != 1 {
+		return nil, nil
+	}
+
+	tv, ok := pass.TypesInfo.Types[origCall.Fun]
+	if !ok || !tv.IsType() {
+		return nil, nil
+	}
+
+	slice, ok := tv.Type.Underlying().(*gotypes.Slice)
+	if !ok {
+		return nil, nil
+	}
+
+	if elem, ok := slice.Elem().Underlying().(*gotypes.Basic); !ok || elem.Kind() != gotypes.Byte {
+		return nil, nil
+	}
+
+	val := pass.TypesInfo.Types[origCall.Args[0]].Value
+	if val == nil || val.Kind() != constant.String {
+		return nil, nil
+	}
+
+	return val, cloneCall.Args[0]
+}
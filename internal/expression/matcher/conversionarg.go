@@ -0,0 +1,30 @@
+package matcher
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// getRedundantConversionOperand returns the converted value (from the clone), if the only argument
+// of the matcher is a conversion of a value to its own type; e.g. `Equal(int(y))`, when y is
+// already an int
+func getRedundantConversionOperand(orig, clone *ast.CallExpr, pass *analysis.Pass) ast.Expr {
+	if len(orig.Args) != 1 {
+		return nil
+	}
+
+	origCall, ok := orig.Args[0].(*ast.CallExpr)
+	if !ok || !funccall.IsRedundantConversion(pass, origCall) {
+		return nil
+	}
+
+	cloneCall, ok := clone.Args[0].(*ast.CallExpr)
+	if !ok || len(cloneCall.Args) != 1 {
+		return nil
+	}
+
+	return cloneCall.Args[0]
+}
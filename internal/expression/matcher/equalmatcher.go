@@ -29,14 +29,21 @@ func newEqualMatcher(orig, clone ast.Expr, pass *analysis.Pass) Info {
 	}
 
 	val := value.GetValuer(orig, clone, pass)
+	convVal, convArg := getBytesConversion(orig, clone, pass)
 
 	return &EqualMatcher{
-		val: val,
+		val:      val,
+		elements: getSliceLiteralElements(orig, clone, pass),
+		convVal:  convVal,
+		convArg:  convArg,
 	}
 }
 
 type EqualMatcher struct {
-	val value.Valuer
+	val      value.Valuer
+	elements []ast.Expr
+	convVal  constant.Value
+	convArg  ast.Expr
 }
 
 func (EqualMatcher) Type() Type {
@@ -59,6 +66,18 @@ func (m EqualMatcher) GetValueExpr() ast.Expr {
 	return m.val.GetValueExpr()
 }
 
+// GetSliceLiteralElements returns the elements of the expected value, if it is a slice literal,
+// and the elements can be passed as separated values; e.g. to the HaveExactElements matcher
+func (m EqualMatcher) GetSliceLiteralElements() ([]ast.Expr, bool) {
+	return m.elements, len(m.elements) > 0
+}
+
+// GetBytesConversion returns the string constant and the converted expression, if the expected
+// value is a conversion of a string constant to a byte slice type; e.g. `json.RawMessage(`{"a": 1}`)`
+func (m EqualMatcher) GetBytesConversion() (constant.Value, ast.Expr, bool) {
+	return m.convVal, m.convArg, m.convArg != nil
+}
+
 func (m EqualMatcher) IsValueZero() bool {
 	return m.val.IsValueZero()
 }
@@ -9,3 +9,13 @@ func (HaveLenZeroMatcher) Type() Type {
 func (HaveLenZeroMatcher) MatcherName() string {
 	return haveLen
 }
+
+type HaveCapZeroMatcher struct{}
+
+func (HaveCapZeroMatcher) Type() Type {
+	return HaveCapZeroMatcherType
+}
+
+func (HaveCapZeroMatcher) MatcherName() string {
+	return haveCap
+}
@@ -6,6 +6,7 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
+	"github.com/nunnatsa/ginkgolinter/types"
 )
 
 const ( // gomega matchers
@@ -16,9 +17,11 @@ const ( // gomega matchers
 	beNil          = "BeNil"
 	beNumerically  = "BeNumerically"
 	beTrue         = "BeTrue"
+	containElement = "ContainElement"
 	beZero         = "BeZero"
 	equal          = "Equal"
 	haveLen        = "HaveLen"
+	haveCap        = "HaveCap"
 	haveValue      = "HaveValue"
 	and            = "And"
 	or             = "Or"
@@ -29,18 +32,31 @@ const ( // gomega matchers
 )
 
 type Matcher struct {
-	funcName     string
-	Orig         *ast.CallExpr
-	Clone        *ast.CallExpr
-	info         Info
-	reverseLogic bool
-	handler      gomegahandler.Handler
+	funcName      string
+	Orig          *ast.CallExpr
+	Clone         *ast.CallExpr
+	info          Info
+	reverseLogic  bool
+	notCount      int
+	aliased       bool
+	sprintfFormat ast.Expr
+	convOperand   ast.Expr
+	wrappedErr    ast.Expr
+	handler       gomegahandler.Handler
 }
 
-func New(origMatcher, matcherClone *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Handler) (*Matcher, bool) {
+func New(origMatcher, matcherClone *ast.CallExpr, pass *analysis.Pass, handler gomegahandler.Handler, aliases types.MatcherAliases) (*Matcher, bool) {
 	reverse := false
+	notCount := 0
+	aliased := false
 	var assertFuncName string
 	for {
+		if name, ok := getAliasedMatcherName(origMatcher, aliases); ok {
+			assertFuncName = name
+			aliased = true
+			break
+		}
+
 		info, ok := handler.GetGomegaBasicInfo(origMatcher)
 		if !ok {
 			return nil, false
@@ -52,6 +68,7 @@ func New(origMatcher, matcherClone *ast.CallExpr, pass *analysis.Pass, handler g
 		}
 
 		reverse = !reverse
+		notCount++
 		origMatcher, ok = origMatcher.Args[0].(*ast.CallExpr)
 		if !ok {
 			return nil, false
@@ -60,12 +77,17 @@ func New(origMatcher, matcherClone *ast.CallExpr, pass *analysis.Pass, handler g
 	}
 
 	return &Matcher{
-		funcName:     assertFuncName,
-		Orig:         origMatcher,
-		Clone:        matcherClone,
-		info:         getMatcherInfo(origMatcher, matcherClone, assertFuncName, pass, handler),
-		reverseLogic: reverse,
-		handler:      handler,
+		funcName:      assertFuncName,
+		Orig:          origMatcher,
+		Clone:         matcherClone,
+		info:          getMatcherInfo(origMatcher, matcherClone, assertFuncName, pass, handler, aliases),
+		reverseLogic:  reverse,
+		notCount:      notCount,
+		aliased:       aliased,
+		sprintfFormat: getVerbLessSprintfArg(origMatcher, matcherClone, pass),
+		convOperand:   getRedundantConversionOperand(origMatcher, matcherClone, pass),
+		wrappedErr:    getWrappedErrorArg(origMatcher, matcherClone, pass),
+		handler:       handler,
 	}, true
 }
 
@@ -73,11 +95,45 @@ func (m *Matcher) ShouldReverseLogic() bool {
 	return m.reverseLogic
 }
 
+// IsWrappedWithNot returns true if the original matcher was wrapped with a single Not() matcher.
+// Multiple Not() wrappers are a double negation, and not a replacement of the assertion method.
+func (m *Matcher) IsWrappedWithNot() bool {
+	return m.notCount == 1
+}
+
+// GetVerbLessSprintfFormat returns the format string of the matcher argument, if this argument is a
+// fmt.Sprintf call with no formatting verbs; e.g. `ContainSubstring(fmt.Sprintf("literal"))`
+func (m *Matcher) GetVerbLessSprintfFormat() (ast.Expr, bool) {
+	return m.sprintfFormat, m.sprintfFormat != nil
+}
+
+// GetRedundantConversionOperand returns the converted value of the matcher argument, if this
+// argument is a conversion of a value to its own type; e.g. `Equal(int(y))`, when y is an int
+func (m *Matcher) GetRedundantConversionOperand() (ast.Expr, bool) {
+	return m.convOperand, m.convOperand != nil
+}
+
+// GetWrappedError returns the wrapped error of the matcher argument, if this argument is a
+// fmt.Errorf call with a single %w verb; e.g. `Equal(fmt.Errorf("wrap: %w", inner))`
+func (m *Matcher) GetWrappedError() (ast.Expr, bool) {
+	return m.wrappedErr, m.wrappedErr != nil
+}
+
+// IsAliased returns true if the matcher is a custom wrapper matcher, configured as an alias of a
+// gomega matcher
+func (m *Matcher) IsAliased() bool {
+	return m.aliased
+}
+
 func (m *Matcher) GetMatcherInfo() Info {
 	return m.info
 }
 
 func (m *Matcher) ReplaceMatcherFuncName(name string) {
+	if m.aliased { // the wrapper matcher is not a gomega function, so the handler can't replace it
+		replaceFuncName(m.Clone, ast.NewIdent(name))
+		return
+	}
 	m.handler.ReplaceFunction(m.Clone, ast.NewIdent(name))
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/nunnatsa/ginkgolinter/internal/expression/value"
 	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
+	"github.com/nunnatsa/ginkgolinter/types"
 )
 
 type Type uint64
@@ -20,6 +21,7 @@ const (
 	BeFalseMatcherType
 	BeNumericallyMatcherType
 	HaveLenZeroMatcherType
+	HaveCapZeroMatcherType
 	BeEquivalentToMatcherType
 	BeIdenticalToMatcherType
 	BeNilMatcherType
@@ -32,6 +34,7 @@ const (
 	HaveOccurredMatcherType
 	SucceedMatcherType
 	EqualNilMatcherType
+	ContainElementMatcherType
 
 	BoolValueFalse
 	BoolValueTrue
@@ -53,7 +56,11 @@ type Info interface {
 	MatcherName() string
 }
 
-func getMatcherInfo(orig, clone *ast.CallExpr, matcherName string, pass *analysis.Pass, handler gomegahandler.Handler) Info {
+func getMatcherInfo(orig, clone *ast.CallExpr, matcherName string, pass *analysis.Pass, handler gomegahandler.Handler, aliases types.MatcherAliases) Info {
+	if len(orig.Args) < matcherNumArgs[matcherName] {
+		return &UnspecifiedMatcher{matcherName: matcherName}
+	}
+
 	switch matcherName {
 	case equal:
 		return newEqualMatcher(orig.Args[0], clone.Args[0], pass)
@@ -83,6 +90,11 @@ func getMatcherInfo(orig, clone *ast.CallExpr, matcherName string, pass *analysi
 			return &HaveLenZeroMatcher{}
 		}
 
+	case haveCap:
+		if value.GetValuer(orig.Args[0], clone.Args[0], pass).IsValueZero() {
+			return &HaveCapZeroMatcher{}
+		}
+
 	case beEquivalentTo:
 		return &BeEquivalentToMatcher{
 			Value: value.New(orig.Args[0], clone.Args[0], pass),
@@ -97,14 +109,14 @@ func getMatcherInfo(orig, clone *ast.CallExpr, matcherName string, pass *analysi
 		return newMatchErrorMatcher(orig.Args, pass)
 
 	case haveValue:
-		if nestedMatcher, ok := getNestedMatcher(orig, clone, 0, pass, handler); ok {
+		if nestedMatcher, ok := getNestedMatcher(orig, clone, 0, pass, handler, aliases); ok {
 			return &HaveValueMatcher{
 				nested: nestedMatcher,
 			}
 		}
 
 	case withTransform:
-		if nestedMatcher, ok := getNestedMatcher(orig, clone, 1, pass, handler); ok {
+		if nestedMatcher, ok := getNestedMatcher(orig, clone, 1, pass, handler, aliases); ok {
 			return newWithTransformMatcher(orig.Args[0], nestedMatcher, pass)
 		}
 
@@ -116,7 +128,7 @@ func getMatcherInfo(orig, clone *ast.CallExpr, matcherName string, pass *analysi
 			matcherType |= AndMatherType
 		}
 
-		if m, ok := newMultipleMatchersMatcher(matcherType, orig.Args, clone.Args, pass, handler); ok {
+		if m, ok := newMultipleMatchersMatcher(matcherType, orig.Args, clone.Args, pass, handler, aliases); ok {
 			return m
 		}
 
@@ -126,6 +138,13 @@ func getMatcherInfo(orig, clone *ast.CallExpr, matcherName string, pass *analysi
 	case haveOccurred:
 		return &HaveOccurredMatcher{}
 
+	case containElement:
+		if nestedMatcher, ok := getNestedMatcher(orig, clone, 0, pass, handler, aliases); ok {
+			return &ContainElementMatcher{
+				nested: nestedMatcher,
+			}
+		}
+
 	}
 
 	return &UnspecifiedMatcher{matcherName: matcherName}
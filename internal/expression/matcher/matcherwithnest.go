@@ -7,6 +7,7 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
+	"github.com/nunnatsa/ginkgolinter/types"
 )
 
 type HaveValueMatcher struct {
@@ -44,11 +45,29 @@ func (m *WithTransformMatcher) GetFuncType() gotypes.Type {
 	return m.funcType
 }
 
-func getNestedMatcher(orig, clone *ast.CallExpr, offset int, pass *analysis.Pass, handler gomegahandler.Handler) (*Matcher, bool) {
+// ContainElementMatcher is a ContainElement matcher, with a nested matcher; e.g.
+// `ContainElement(Equal(x))`
+type ContainElementMatcher struct {
+	nested *Matcher
+}
+
+func (m *ContainElementMatcher) Type() Type {
+	return ContainElementMatcherType
+}
+
+func (m *ContainElementMatcher) MatcherName() string {
+	return containElement
+}
+
+func (m *ContainElementMatcher) GetNested() *Matcher {
+	return m.nested
+}
+
+func getNestedMatcher(orig, clone *ast.CallExpr, offset int, pass *analysis.Pass, handler gomegahandler.Handler, aliases types.MatcherAliases) (*Matcher, bool) {
 	if origNested, ok := orig.Args[offset].(*ast.CallExpr); ok {
 		cloneNested := clone.Args[offset].(*ast.CallExpr)
 
-		return New(origNested, cloneNested, pass, handler)
+		return New(origNested, cloneNested, pass, handler, aliases)
 	}
 
 	return nil, false
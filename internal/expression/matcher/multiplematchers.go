@@ -6,6 +6,7 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/nunnatsa/ginkgolinter/internal/gomegahandler"
+	"github.com/nunnatsa/ginkgolinter/types"
 )
 
 type MultipleMatchersMatcher struct {
@@ -24,7 +25,7 @@ func (m *MultipleMatchersMatcher) MatcherName() string {
 	return and
 }
 
-func newMultipleMatchersMatcher(matherType Type, orig, clone []ast.Expr, pass *analysis.Pass, handler gomegahandler.Handler) (*MultipleMatchersMatcher, bool) {
+func newMultipleMatchersMatcher(matherType Type, orig, clone []ast.Expr, pass *analysis.Pass, handler gomegahandler.Handler, aliases types.MatcherAliases) (*MultipleMatchersMatcher, bool) {
 	matchers := make([]*Matcher, len(orig))
 
 	for i := range orig {
@@ -33,7 +34,7 @@ func newMultipleMatchersMatcher(matherType Type, orig, clone []ast.Expr, pass *a
 			return nil, false
 		}
 
-		m, ok := New(nestedOrig, clone[i].(*ast.CallExpr), pass, handler)
+		m, ok := New(nestedOrig, clone[i].(*ast.CallExpr), pass, handler, aliases)
 		if !ok {
 			return nil, false
 		}
@@ -0,0 +1,90 @@
+package matcher
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// literalDefaultTypes are the default types of untyped literals, by their token kind
+var literalDefaultTypes = map[token.Token]gotypes.Type{
+	token.INT:    gotypes.Typ[gotypes.Int],
+	token.FLOAT:  gotypes.Typ[gotypes.Float64],
+	token.IMAG:   gotypes.Typ[gotypes.Complex128],
+	token.CHAR:   gotypes.Typ[gotypes.Rune],
+	token.STRING: gotypes.Typ[gotypes.String],
+}
+
+// getSliceLiteralElements returns the elements of a non-empty slice literal, from the clone, if
+// each one of them keeps its type when it is passed as an any parameter; e.g. the elements of
+// `[]int64{1, 2}` are not returned, because a bare `1` becomes an int.
+//
+// Literals with keys, and literals with elided element types, like `[]Point{{1, 2}}`, are not
+// supported.
+func getSliceLiteralElements(orig, clone ast.Expr, pass *analysis.Pass) []ast.Expr {
+	origLit, ok := ast.Unparen(orig).(*ast.CompositeLit)
+	if !ok || len(origLit.Elts) == 0 {
+		return nil
+	}
+
+	cloneLit, ok := ast.Unparen(clone).(*ast.CompositeLit)
+	if !ok || len(cloneLit.Elts) != len(origLit.Elts) {
+		return nil
+	}
+
+	t := pass.TypesInfo.TypeOf(origLit)
+	if t == nil {
+		return nil
+	}
+
+	slice, ok := t.Underlying().(*gotypes.Slice)
+	if !ok {
+		return nil
+	}
+
+	for _, elt := range origLit.Elts {
+		if !keepsType(elt, slice.Elem(), pass) {
+			return nil
+		}
+	}
+
+	return cloneLit.Elts
+}
+
+// keepsType checks if the element of the slice literal has the element type of the slice, by
+// itself
+func keepsType(elt ast.Expr, elemType gotypes.Type, pass *analysis.Pass) bool {
+	switch e := ast.Unparen(elt).(type) {
+	case *ast.KeyValueExpr:
+		return false
+
+	case *ast.CompositeLit:
+		if e.Type == nil {
+			return false
+		}
+
+	case *ast.BasicLit:
+		return gotypes.IsInterface(elemType) || gotypes.Identical(literalDefaultTypes[e.Kind], elemType)
+
+	case *ast.Ident:
+		if c, ok := pass.TypesInfo.ObjectOf(e).(*gotypes.Const); ok {
+			return gotypes.IsInterface(elemType) || gotypes.Identical(gotypes.Default(c.Type()), elemType)
+		}
+	}
+
+	// Equal(nil) always fails, so nil elements can't be passed separately
+	tv, ok := pass.TypesInfo.Types[elt]
+	if !ok || tv.IsNil() {
+		return false
+	}
+
+	// the type of a constant expression may be converted to the element type; e.g. `1 << 2`
+	if tv.Value != nil {
+		call, ok := ast.Unparen(elt).(*ast.CallExpr)
+		return ok && pass.TypesInfo.Types[call.Fun].IsType()
+	}
+
+	return true
+}
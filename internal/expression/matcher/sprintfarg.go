@@ -0,0 +1,43 @@
+package matcher
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// getVerbLessSprintfArg returns the format string literal (from the clone), if the only argument
+// of the matcher is a fmt.Sprintf call with no formatting verbs, and no other arguments; e.g.
+// `ContainSubstring(fmt.Sprintf("literal"))`
+func getVerbLessSprintfArg(orig, clone *ast.CallExpr, pass *analysis.Pass) ast.Expr {
+	if len(orig.Args) != 1 {
+		return nil
+	}
+
+	origCall, ok := orig.Args[0].(*ast.CallExpr)
+	if !ok || len(origCall.Args) != 1 || !funccall.IsPkgFunc(pass, origCall, "fmt", "Sprintf") {
+		return nil
+	}
+
+	lit, ok := origCall.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	format, err := strconv.Unquote(lit.Value)
+	if err != nil || strings.Contains(format, "%") {
+		return nil
+	}
+
+	cloneCall, ok := clone.Args[0].(*ast.CallExpr)
+	if !ok || len(cloneCall.Args) != 1 {
+		return nil
+	}
+
+	return cloneCall.Args[0]
+}
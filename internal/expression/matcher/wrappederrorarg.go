@@ -0,0 +1,87 @@
+package matcher
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/internal/funccall"
+)
+
+// getWrappedErrorArg returns the wrapped error of the matcher argument, from the matcher clone, if
+// this argument is a fmt.Errorf call, with a single %w verb; e.g. `inner`, for
+// `Equal(fmt.Errorf("wrap: %w", inner))`
+func getWrappedErrorArg(orig, clone *ast.CallExpr, pass *analysis.Pass) ast.Expr {
+	if len(orig.Args) != 1 {
+		return nil
+	}
+
+	origCall, ok := orig.Args[0].(*ast.CallExpr)
+	if !ok || len(origCall.Args) < 2 || !funccall.IsPkgFunc(pass, origCall, "fmt", "Errorf") {
+		return nil
+	}
+
+	lit, ok := origCall.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	format, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil
+	}
+
+	index, ok := getWrapVerbIndex(format)
+	if !ok || index+1 >= len(origCall.Args) {
+		return nil
+	}
+
+	cloneCall, ok := clone.Args[0].(*ast.CallExpr)
+	if !ok || len(cloneCall.Args) != len(origCall.Args) {
+		return nil
+	}
+
+	return cloneCall.Args[index+1]
+}
+
+// getWrapVerbIndex returns the index of the argument of the %w verb in the format string. The
+// format must have exactly one %w verb, and no explicit argument indexes or `*` widths.
+func getWrapVerbIndex(format string) (int, bool) {
+	index, wrapIndex := 0, -1
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		i++
+		for i < len(format) && isFormatFlagOrWidth(format[i]) {
+			i++
+		}
+
+		if i == len(format) {
+			return 0, false
+		}
+
+		switch format[i] {
+		case '%':
+			continue
+		case '[', '*':
+			return 0, false
+		case 'w':
+			if wrapIndex != -1 {
+				return 0, false
+			}
+			wrapIndex = index
+		}
+
+		index++
+	}
+
+	return wrapIndex, wrapIndex != -1
+}
+
+func isFormatFlagOrWidth(c byte) bool {
+	return c == '+' || c == '-' || c == '#' || c == ' ' || c == '.' || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,94 @@
+package funccall
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// GetPkgFunc returns the package path and the name of the function called by the call expression,
+// if the called function is a package level function; e.g. `errors.Is`.
+//
+// The function is resolved using the type information, so it also works for aliased or dot imports.
+func GetPkgFunc(pass *analysis.Pass, call *ast.CallExpr) (string, string, bool) {
+	fn := typeutil.StaticCallee(pass.TypesInfo, call)
+	if fn == nil || fn.Pkg() == nil {
+		return "", "", false
+	}
+
+	if sig, ok := fn.Type().(*gotypes.Signature); !ok || sig.Recv() != nil {
+		return "", "", false
+	}
+
+	return fn.Pkg().Path(), fn.Name(), true
+}
+
+// IsPkgFunc checks if the call expression calls one of the named functions of the pkgPath package
+func IsPkgFunc(pass *analysis.Pass, call *ast.CallExpr, pkgPath string, names ...string) bool {
+	path, name, ok := GetPkgFunc(pass, call)
+	if !ok || path != pkgPath {
+		return false
+	}
+
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetMethod returns the receiver type and the name of the method called by the call expression,
+// if the called function is a method; e.g. `t.After(other)`.
+func GetMethod(pass *analysis.Pass, call *ast.CallExpr) (gotypes.Type, string, bool) {
+	fn := typeutil.StaticCallee(pass.TypesInfo, call)
+	if fn == nil {
+		return nil, "", false
+	}
+
+	sig, ok := fn.Type().(*gotypes.Signature)
+	if !ok || sig.Recv() == nil {
+		return nil, "", false
+	}
+
+	return sig.Recv().Type(), fn.Name(), true
+}
+
+// IsMethodOf checks if the call expression calls the named method of the pkgPath.typeName type,
+// or of a pointer to this type; e.g. `wg.Wait()` of sync.WaitGroup
+func IsMethodOf(pass *analysis.Pass, call *ast.CallExpr, pkgPath, typeName, methodName string) bool {
+	recv, name, ok := GetMethod(pass, call)
+	if !ok || name != methodName {
+		return false
+	}
+
+	if ptr, ok := recv.(*gotypes.Pointer); ok {
+		recv = ptr.Elem()
+	}
+
+	named, ok := recv.(*gotypes.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == pkgPath && named.Obj().Name() == typeName
+}
+
+// IsRedundantConversion checks if the call expression is a type conversion of a value, that is
+// already of the target type; e.g. `int(x)`, when x is an int. Conversions of constants are never
+// considered as redundant, because they may set the type of an untyped constant.
+func IsRedundantConversion(pass *analysis.Pass, call *ast.CallExpr) bool {
+	if len(call.Args) != 1 || call.Ellipsis.IsValid() {
+		return false
+	}
+
+	if tv, ok := pass.TypesInfo.Types[call.Fun]; !ok || !tv.IsType() {
+		return false
+	}
+
+	operand, ok := pass.TypesInfo.Types[call.Args[0]]
+	if !ok || operand.Type == nil || operand.Value != nil {
+		return false
+	}
+
+	return gotypes.Identical(operand.Type, pass.TypesInfo.TypeOf(call))
+}
@@ -16,9 +16,20 @@ func (h dotHandler) HandleGinkgoSpecs(expr ast.Expr, config types.Config, pass *
 	return handleGinkgoSpecs(expr, config, pass, h)
 }
 
-func (h dotHandler) getFocusContainerName(exp *ast.CallExpr) (bool, *ast.Ident) {
+func (h dotHandler) HandleGinkgoLoop(loop ast.Stmt, config types.Config, pass *analysis.Pass) bool {
+	return handleGinkgoLoop(loop, config, pass, h)
+}
+
+func (h dotHandler) getGinkgoFuncIdent(exp *ast.CallExpr) *ast.Ident {
 	if fun, ok := exp.Fun.(*ast.Ident); ok {
-		return isFocusContainer(fun.Name), fun
+		return fun
+	}
+	return nil
+}
+
+func (h dotHandler) getFocusContainerName(exp *ast.CallExpr) (bool, *ast.Ident) {
+	if id := h.getGinkgoFuncIdent(exp); id != nil {
+		return isFocusContainer(id.Name), id
 	}
 	return false, nil
 }
@@ -21,6 +21,11 @@ const ( // container names
 	xit = "XIt"
 	fit = "FIt"
 
+	specify  = "Specify"
+	pspecify = "PSpecify"
+	xspecify = "XSpecify"
+	fspecify = "FSpecify"
+
 	describeTable  = "DescribeTable"
 	pdescribeTable = "PDescribeTable"
 	xdescribeTable = "XDescribeTable"
@@ -34,7 +39,16 @@ const ( // container names
 
 func isFocusContainer(name string) bool {
 	switch name {
-	case fdescribe, fcontext, fwhen, fit, fdescribeTable, fentry:
+	case fdescribe, fcontext, fwhen, fit, fspecify, fdescribeTable, fentry:
+		return true
+	}
+	return false
+}
+
+func isPendingContainer(name string) bool {
+	switch name {
+	case pdescribe, pcontext, pwhen, pit, pspecify, pdescribeTable, pentry,
+		xdescribe, xcontext, xwhen, xit, xspecify, xdescribeTable, xentry:
 		return true
 	}
 	return false
@@ -42,9 +56,9 @@ func isFocusContainer(name string) bool {
 
 func isContainer(name string) bool {
 	switch name {
-	case it, when, contextContainer, describe, describeTable, entry,
-		pit, pwhen, pcontext, pdescribe, pdescribeTable, pentry,
-		xit, xwhen, xcontext, xdescribe, xdescribeTable, xentry:
+	case it, specify, when, contextContainer, describe, describeTable, entry,
+		pit, pspecify, pwhen, pcontext, pdescribe, pdescribeTable, pentry,
+		xit, xspecify, xwhen, xcontext, xdescribe, xdescribeTable, xentry:
 		return true
 	}
 	return isFocusContainer(name)
@@ -61,3 +75,27 @@ func isWrapContainer(name string) bool {
 
 	return false
 }
+
+func isTable(name string) bool {
+	switch name {
+	case describeTable, fdescribeTable, pdescribeTable, xdescribeTable:
+		return true
+	}
+	return false
+}
+
+func isEntry(name string) bool {
+	switch name {
+	case entry, fentry, pentry, xentry:
+		return true
+	}
+	return false
+}
+
+func isSpec(name string) bool {
+	switch name {
+	case it, fit, pit, xit, specify, fspecify, pspecify, xspecify:
+		return true
+	}
+	return false
+}
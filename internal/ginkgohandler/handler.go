@@ -19,6 +19,8 @@ const (
 // in imported with "." name, custom name or without any name.
 type Handler interface {
 	HandleGinkgoSpecs(ast.Expr, types.Config, *analysis.Pass) bool
+	HandleGinkgoLoop(ast.Stmt, types.Config, *analysis.Pass) bool
+	getGinkgoFuncIdent(*ast.CallExpr) *ast.Ident
 	getFocusContainerName(*ast.CallExpr) (bool, *ast.Ident)
 	isWrapContainer(*ast.CallExpr) bool
 	isFocusSpec(ident ast.Expr) bool
@@ -14,6 +14,7 @@ const (
 	linterName            = "ginkgo-linter"
 	focusContainerFound   = linterName + ": Focus container found. This is used only for local debug and should not be part of the actual source code. Consider to replace with %q"
 	focusSpecFound        = linterName + ": Focus spec found. This is used only for local debug and should not be part of the actual source code. Consider to remove it"
+	pendingContainerFound = linterName + ": Pending container found. The spec will not run. Consider to replace with %q"
 	useBeforeEachTemplate = "use BeforeEach() to assign variable %s"
 )
 
@@ -24,9 +25,17 @@ func handleGinkgoSpecs(expr ast.Expr, config types.Config, pass *analysis.Pass,
 			goDeeper = true
 		}
 
+		if config.ForbidPending && checkPendingContainer(pass, ginkgoHndlr, exp) {
+			goDeeper = true
+		}
+
 		if config.ForbidSpecPollution && checkAssignmentsInContainer(pass, ginkgoHndlr, exp) {
 			goDeeper = true
 		}
+
+		if checkTableEntries(pass, ginkgoHndlr, exp) {
+			goDeeper = true
+		}
 	}
 	return goDeeper
 }
@@ -139,7 +148,7 @@ func checkFocusContainer(pass *analysis.Pass, handler Handler, exp *ast.CallExpr
 	foundFocus := false
 	isFocus, id := handler.getFocusContainerName(exp)
 	if isFocus {
-		reportNewName(pass, id, id.Name[1:], id.Name)
+		reportNewName(pass, id, id.Name[1:], id.Name, focusContainerFound)
 		foundFocus = true
 	}
 
@@ -159,10 +168,31 @@ func checkFocusContainer(pass *analysis.Pass, handler Handler, exp *ast.CallExpr
 	return foundFocus
 }
 
-func reportNewName(pass *analysis.Pass, id *ast.Ident, newName string, oldExpr string) {
+func checkPendingContainer(pass *analysis.Pass, handler Handler, exp *ast.CallExpr) bool {
+	foundPending := false
+	id := handler.getGinkgoFuncIdent(exp)
+	if id != nil && isPendingContainer(id.Name) {
+		reportNewName(pass, id, id.Name[1:], id.Name, pendingContainerFound)
+		foundPending = true
+	}
+
+	if id != nil && isContainer(id.Name) {
+		for _, arg := range exp.Args {
+			if callExp, ok := arg.(*ast.CallExpr); ok {
+				if checkPendingContainer(pass, handler, callExp) { // handle table entries
+					foundPending = true
+				}
+			}
+		}
+	}
+
+	return foundPending
+}
+
+func reportNewName(pass *analysis.Pass, id *ast.Ident, newName string, oldExpr string, template string) {
 	pass.Report(analysis.Diagnostic{
 		Pos:     id.Pos(),
-		Message: fmt.Sprintf(focusContainerFound, newName),
+		Message: fmt.Sprintf(template, newName),
 		SuggestedFixes: []analysis.SuggestedFix{
 			{
 				Message: fmt.Sprintf("should replace %s with %s", oldExpr, newName),
@@ -0,0 +1,129 @@
+package ginkgohandler
+
+import (
+	"go/ast"
+	"go/token"
+	gotypes "go/types"
+	"go/version"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	loopVarCaptured = linterName + ": the %[1]s loop variable is captured by the %[2]s closure; before go 1.22, all the specs share the same variable, and see its last value. Copy it to a local variable in the loop body; e.g. `%[1]s := %[1]s`"
+
+	// perIterationLoopVarsVersion is the go version that creates a new loop variable for each iteration
+	perIterationLoopVarsVersion = "go1.22"
+)
+
+func handleGinkgoLoop(loop ast.Stmt, config types.Config, pass *analysis.Pass, handler Handler) bool {
+	if !config.ForbidLoopVarCapture || !hasSharedLoopVars(pass, loop) {
+		return false
+	}
+
+	return checkLoopVarCapture(pass, handler, loop)
+}
+
+// hasSharedLoopVars checks if all the iterations of the loop share the same loop variables; this is
+// the case, if the go version of the file or of the package is older than go 1.22, or is unknown.
+func hasSharedLoopVars(pass *analysis.Pass, loop ast.Stmt) bool {
+	goVersion := ""
+	for _, file := range pass.Files {
+		if file.FileStart <= loop.Pos() && loop.Pos() < file.FileEnd {
+			goVersion = pass.TypesInfo.FileVersions[file]
+			break
+		}
+	}
+
+	if goVersion == "" && pass.Pkg != nil {
+		goVersion = pass.Pkg.GoVersion()
+	}
+
+	return !version.IsValid(goVersion) || version.Compare(goVersion, perIterationLoopVarsVersion) < 0
+}
+
+// checkLoopVarCapture finds spec closures (e.g. `It("...", func() {...})`) in the body of the loop,
+// that use the loop variables
+func checkLoopVarCapture(pass *analysis.Pass, handler Handler, loop ast.Stmt) bool {
+	var (
+		loopVars []ast.Expr
+		body     *ast.BlockStmt
+	)
+
+	switch l := loop.(type) {
+	case *ast.RangeStmt:
+		if l.Tok != token.DEFINE {
+			return false
+		}
+		loopVars = []ast.Expr{l.Key, l.Value}
+		body = l.Body
+
+	case *ast.ForStmt:
+		init, ok := l.Init.(*ast.AssignStmt)
+		if !ok || init.Tok != token.DEFINE {
+			return false
+		}
+		loopVars = init.Lhs
+		body = l.Body
+
+	default:
+		return false
+	}
+
+	vars := map[gotypes.Object]bool{}
+	for _, v := range loopVars {
+		if ident, ok := v.(*ast.Ident); ok && ident.Name != "_" {
+			if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+				vars[obj] = true
+			}
+		}
+	}
+
+	if len(vars) == 0 || body == nil {
+		return false
+	}
+
+	foundSomething := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		id := handler.getGinkgoFuncIdent(call)
+		if id == nil || !isSpec(id.Name) {
+			return true
+		}
+
+		for _, arg := range call.Args {
+			if fn, ok := arg.(*ast.FuncLit); ok && reportCapturedLoopVars(pass, fn, id.Name, vars) {
+				foundSomething = true
+			}
+		}
+
+		return false
+	})
+
+	return foundSomething
+}
+
+// reportCapturedLoopVars reports the first use of each loop variable in the spec closure
+func reportCapturedLoopVars(pass *analysis.Pass, fn *ast.FuncLit, specName string, vars map[gotypes.Object]bool) bool {
+	reported := map[gotypes.Object]bool{}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		if obj := pass.TypesInfo.Uses[ident]; obj != nil && vars[obj] && !reported[obj] {
+			reportNoFix(pass, ident.Pos(), loopVarCaptured, ident.Name, specName)
+			reported[obj] = true
+		}
+		return true
+	})
+
+	return len(reported) > 0
+}
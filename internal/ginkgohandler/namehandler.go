@@ -19,12 +19,23 @@ func (h nameHandler) HandleGinkgoSpecs(expr ast.Expr, config types.Config, pass
 	return handleGinkgoSpecs(expr, config, pass, h)
 }
 
-func (h nameHandler) getFocusContainerName(exp *ast.CallExpr) (bool, *ast.Ident) {
+func (h nameHandler) HandleGinkgoLoop(loop ast.Stmt, config types.Config, pass *analysis.Pass) bool {
+	return handleGinkgoLoop(loop, config, pass, h)
+}
+
+func (h nameHandler) getGinkgoFuncIdent(exp *ast.CallExpr) *ast.Ident {
 	if sel, ok := exp.Fun.(*ast.SelectorExpr); ok {
 		if id, ok := sel.X.(*ast.Ident); ok && id.Name == string(h) {
-			return isFocusContainer(sel.Sel.Name), sel.Sel
+			return sel.Sel
 		}
 	}
+	return nil
+}
+
+func (h nameHandler) getFocusContainerName(exp *ast.CallExpr) (bool, *ast.Ident) {
+	if id := h.getGinkgoFuncIdent(exp); id != nil {
+		return isFocusContainer(id.Name), id
+	}
 	return false, nil
 }
 
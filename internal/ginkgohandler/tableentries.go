@@ -0,0 +1,161 @@
+package ginkgohandler
+
+import (
+	"fmt"
+	"go/ast"
+	gotypes "go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	wrongEntryParams         = linterName + ": wrong number of parameters in %s: the table function receives %s, but the entry passes %d; this will panic in runtime"
+	wrongEntryParamsVariadic = linterName + ": wrong number of parameters in %s: the table function receives at least %s, but the entry passes %d; this will panic in runtime"
+
+	ginkgoPkgPath = "github.com/onsi/ginkgo"
+)
+
+// checkTableEntries validates that the number of the parameters of each table entry, matches the
+// number of the parameters of the table function; e.g.
+//
+//	DescribeTable("...", func(a int, b string) {...},
+//		Entry("wrong", 1), // will panic: the table function expects 2 parameters
+//	)
+func checkTableEntries(pass *analysis.Pass, handler Handler, exp *ast.CallExpr) bool {
+	id := handler.getGinkgoFuncIdent(exp)
+	if id == nil || !isTable(id.Name) || len(exp.Args) < 2 {
+		return false
+	}
+
+	bodyIndex, sig := getTableBody(pass, exp.Args)
+	if sig == nil {
+		return false
+	}
+
+	numParams := sig.Params().Len()
+	optionalCtx := numParams > 0 && isContextParam(sig.Params().At(0).Type())
+
+	foundSomething := false
+	for _, arg := range exp.Args[bodyIndex+1:] {
+		entryCall, ok := arg.(*ast.CallExpr)
+		if !ok || entryCall.Ellipsis.IsValid() || len(entryCall.Args) == 0 {
+			continue
+		}
+
+		entryID := handler.getGinkgoFuncIdent(entryCall)
+		if entryID == nil || !isEntry(entryID.Name) || !isEntryDescription(pass, entryCall.Args[0]) {
+			continue
+		}
+
+		entryName := entryID.Name
+
+		numEntryParams := countEntryParams(pass, entryCall.Args[1:])
+
+		if sig.Variadic() {
+			if minParams := numParams - 1; numEntryParams < minParams && !(optionalCtx && numEntryParams == minParams-1) {
+				reportNoFix(pass, entryCall.Pos(), wrongEntryParamsVariadic, entryName, paramsCount(minParams), numEntryParams)
+				foundSomething = true
+			}
+			continue
+		}
+
+		if numEntryParams != numParams && !(optionalCtx && numEntryParams == numParams-1) {
+			reportNoFix(pass, entryCall.Pos(), wrongEntryParams, entryName, paramsCount(numParams), numEntryParams)
+			foundSomething = true
+		}
+	}
+
+	return foundSomething
+}
+
+// paramsCount returns the number of the parameters, with the right noun form; e.g. "1 parameter"
+func paramsCount(n int) string {
+	if n == 1 {
+		return "1 parameter"
+	}
+	return fmt.Sprintf("%d parameters", n)
+}
+
+// getTableBody returns the index and the signature of the table function; it is the first function
+// argument of the table, after its description, that is not a ginkgo decorator
+func getTableBody(pass *analysis.Pass, args []ast.Expr) (int, *gotypes.Signature) {
+	for i, arg := range args[1:] {
+		t := pass.TypesInfo.TypeOf(arg)
+		if t == nil || isGinkgoDecorator(t) {
+			continue
+		}
+
+		if sig, ok := t.Underlying().(*gotypes.Signature); ok {
+			return i + 1, sig
+		}
+	}
+
+	return 0, nil
+}
+
+// countEntryParams counts the entry parameters that are passed to the table function; i.e. without
+// the ginkgo decorators, like Focus or Label()
+func countEntryParams(pass *analysis.Pass, args []ast.Expr) int {
+	count := 0
+	for _, arg := range args {
+		if !isGinkgoDecorator(pass.TypesInfo.TypeOf(arg)) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isEntryDescription checks if the first argument of the entry is a valid description: a string, nil
+// or a function that returns a string. Otherwise, ginkgo fails on the wrong description, and the
+// parameters count is meaningless.
+func isEntryDescription(pass *analysis.Pass, arg ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[arg]
+	if !ok {
+		return false
+	}
+
+	if tv.IsNil() {
+		return true
+	}
+
+	switch t := tv.Type.Underlying().(type) {
+	case *gotypes.Basic:
+		return t.Info()&gotypes.IsString != 0
+	case *gotypes.Signature:
+		return t.Results().Len() == 1 && gotypes.Identical(t.Results().At(0).Type(), gotypes.Typ[gotypes.String])
+	}
+
+	return false
+}
+
+func isGinkgoDecorator(t gotypes.Type) bool {
+	named, ok := gotypes.Unalias(t).(*gotypes.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	// use Contains rather than HasPrefix, to support vendored packages
+	return strings.Contains(named.Obj().Pkg().Path(), ginkgoPkgPath)
+}
+
+// isContextParam checks if the parameter is a context, that ginkgo passes to the table function by
+// itself. SpecContext is an alias of the internal ginkgo type, so the alias is resolved first.
+func isContextParam(t gotypes.Type) bool {
+	named, ok := gotypes.Unalias(t).(*gotypes.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	obj := named.Obj()
+	switch obj.Name() {
+	case "Context":
+		return obj.Pkg().Path() == "context"
+	case "SpecContext":
+		// use HasSuffix rather than equality, to support vendored packages
+		return strings.HasSuffix(obj.Pkg().Path(), ginkgoPkgPath+"/v2/internal")
+	}
+
+	return false
+}
@@ -2,6 +2,8 @@ package gomegahandler
 
 import (
 	"go/ast"
+	gotypes "go/types"
+	"strings"
 
 	"golang.org/x/tools/go/analysis"
 
@@ -20,6 +22,11 @@ func (h dotHandler) GetGomegaBasicInfo(expr *ast.CallExpr) (*GomegaBasicInfo, bo
 	for {
 		switch actualFunc := expr.Fun.(type) {
 		case *ast.Ident:
+			// a local declaration, like `Expect := func(...) {...}`, shadows the dot imported function
+			if obj, ok := h.pass.TypesInfo.Uses[actualFunc]; ok && !isGomegaObject(obj) {
+				return nil, false
+			}
+
 			info.MethodName = actualFunc.Name
 			return info, true
 		case *ast.SelectorExpr:
@@ -44,6 +51,12 @@ func (h dotHandler) GetGomegaBasicInfo(expr *ast.CallExpr) (*GomegaBasicInfo, bo
 	}
 }
 
+// isGomegaObject checks if the object is declared in the gomega package
+func isGomegaObject(obj gotypes.Object) bool {
+	// use HasSuffix rather than equality, to support vendored packages
+	return obj.Pkg() != nil && strings.HasSuffix(obj.Pkg().Path(), pkgPath)
+}
+
 // ReplaceFunction replaces the function with another one, for fix suggestions
 func (dotHandler) ReplaceFunction(caller *ast.CallExpr, newExpr *ast.Ident) {
 	switch f := caller.Fun.(type) {
@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	importPath = `"github.com/onsi/gomega"`
+	pkgPath    = "github.com/onsi/gomega"
+	importPath = `"` + pkgPath + `"`
 )
 
 // Handler provide different handling, depend on the way gomega was imported, whether
@@ -3,6 +3,7 @@ package gomegahandler
 
 import (
 	"go/ast"
+	"go/parser"
 	gotypes "go/types"
 	"testing"
 
@@ -106,6 +107,9 @@ var (
 	gVarVar     = ast.NewIdent("g")
 	gVarPointer = ast.NewIdent("g")
 	noGomegaVar = ast.NewIdent("g")
+	shadowVar   = ast.NewIdent(actualName)
+	shadowFunc  = ast.NewIdent(actualName)
+	gomegaFunc  = ast.NewIdent(actualName)
 )
 
 func newGomegaPass() *analysis.Pass {
@@ -122,6 +126,11 @@ func newGomegaPass() *analysis.Pass {
 					Type: gotypes.NewPointer(gotypes.NewNamed(gotypes.NewTypeName(0, gotypes.NewPackage(`github.com/something/else`, ""), `somethingElse`, &gotypes.Named{}), nil, nil)),
 				},
 			},
+			Uses: map[*ast.Ident]gotypes.Object{
+				shadowVar:  gotypes.NewVar(0, nil, actualName, gotypes.NewSignatureType(nil, nil, nil, nil, nil, false)),
+				shadowFunc: gotypes.NewFunc(0, gotypes.NewPackage(`github.com/something/else`, "else"), actualName, gotypes.NewSignatureType(nil, nil, nil, nil, nil, false)),
+				gomegaFunc: gotypes.NewFunc(0, gotypes.NewPackage(`github.com/onsi/gomega`, "gomega"), actualName, gotypes.NewSignatureType(nil, nil, nil, nil, nil, false)),
+			},
 		},
 	}
 }
@@ -156,6 +165,33 @@ func TestGomegaDotHandler_GetGomegaBasicInfo(t *testing.T) {
 			expectedName:      "",
 			expectedGomegaVar: false,
 		},
+		{
+			name: "local variable shadows the gomega function",
+			exp: &ast.CallExpr{
+				Fun: shadowVar,
+			},
+			expectedOK:        false,
+			expectedName:      "",
+			expectedGomegaVar: false,
+		},
+		{
+			name: "function of another package shadows the gomega function",
+			exp: &ast.CallExpr{
+				Fun: shadowFunc,
+			},
+			expectedOK:        false,
+			expectedName:      "",
+			expectedGomegaVar: false,
+		},
+		{
+			name: "resolved gomega function",
+			exp: &ast.CallExpr{
+				Fun: gomegaFunc,
+			},
+			expectedOK:        true,
+			expectedName:      actualName,
+			expectedGomegaVar: false,
+		},
 		{
 			name: "var happy case gomega var",
 			exp: &ast.CallExpr{
@@ -356,3 +392,47 @@ func TestGomegaNameHandler_ReplaceFunction(t *testing.T) {
 		t.Error("the new function name should be 'two'")
 	}
 }
+
+func TestGetActualExpr_helperMethods(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		handler  Handler
+		expr     string
+		expected string
+	}{
+		{
+			name:     "dot handler",
+			handler:  &dotHandler{},
+			expr:     `Expect(x).WithOffset(1).WithRetry(3).To(Equal(1))`,
+			expected: `Expect(x)`,
+		},
+		{
+			name:     "name handler",
+			handler:  &nameHandler{name: "gomega"},
+			expr:     `gomega.Expect(x).WithOffset(1).WithRetry(3).To(gomega.Equal(1))`,
+			expected: `gomega.Expect(x)`,
+		},
+		{
+			name:     "dot handler async",
+			handler:  &dotHandler{},
+			expr:     `Eventually(f).WithContextTimeout(ctx).WithPolling(time.Second).Should(Succeed())`,
+			expected: `Eventually(f)`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tc.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actualExpr := tc.handler.GetActualExpr(expr.(*ast.CallExpr).Fun.(*ast.SelectorExpr))
+			if actualExpr == nil {
+				t.Fatalf("should find the actual expression of %s", tc.expr)
+			}
+
+			if actual := gotypes.ExprString(actualExpr); actual != tc.expected {
+				t.Errorf("the actual expression should be %s, but it's %s", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -103,6 +103,8 @@ const (
 	gomegaInterface  = "github.com/onsi/gomega/types.Gomega"
 )
 
+// IsGomegaType checks if the type is the gomega type, or a custom type that implements the gomega
+// interface; e.g. a struct that embeds the gomega.Gomega interface.
 func IsGomegaType(t gotypes.Type) bool {
 	switch ttx := gotypes.Unalias(t).(type) {
 	case *gotypes.Pointer:
@@ -110,8 +112,67 @@ func IsGomegaType(t gotypes.Type) bool {
 
 	case *gotypes.Named:
 		name := ttx.String()
-		return strings.HasSuffix(name, gomegaStructType) || strings.HasSuffix(name, gomegaInterface)
+		if strings.HasSuffix(name, gomegaStructType) || strings.HasSuffix(name, gomegaInterface) {
+			return true
+		}
+
+		return implementsGomega(ttx)
 	}
 
 	return false
 }
+
+// implementsGomega checks if the type, or a pointer to it, implements the gomega interface. The
+// interface is taken from the package of the assertion type, that is returned by the Expect
+// method of the type, so there is no need to find the gomega package in the imports.
+func implementsGomega(t *gotypes.Named) bool {
+	obj, _, _ := gotypes.LookupFieldOrMethod(t, true, nil, "Expect")
+	method, ok := obj.(*gotypes.Func)
+	if !ok {
+		return false
+	}
+
+	sig, ok := method.Type().(*gotypes.Signature)
+	if !ok || sig.Results().Len() != 1 {
+		return false
+	}
+
+	assertion, ok := gotypes.Unalias(sig.Results().At(0).Type()).(*gotypes.Named)
+	if !ok || assertion.Obj().Pkg() == nil {
+		return false
+	}
+
+	gomegaObj := assertion.Obj().Pkg().Scope().Lookup("Gomega")
+	if gomegaObj == nil || !strings.HasSuffix(gomegaObj.Type().String(), gomegaInterface) {
+		return false
+	}
+
+	iface, ok := gomegaObj.Type().Underlying().(*gotypes.Interface)
+	if !ok {
+		return false
+	}
+
+	return gotypes.Implements(t, iface) || gotypes.Implements(gotypes.NewPointer(t), iface)
+}
+
+// matcherNames are the names of the gomega matchers
+var matcherNames = map[string]struct{}{
+	"And": {}, "BeADirectory": {}, "BeARegularFile": {}, "BeAnExistingFile": {}, "BeAssignableToTypeOf": {},
+	"BeClosed": {}, "BeComparableTo": {}, "BeElementOf": {}, "BeEmpty": {}, "BeEquivalentTo": {},
+	"BeFalse": {}, "BeFalseBecause": {}, "BeIdenticalTo": {}, "BeKeyOf": {}, "BeNil": {},
+	"BeNumerically": {}, "BeSent": {}, "BeTemporally": {}, "BeTrue": {}, "BeTrueBecause": {},
+	"BeZero": {}, "ConsistOf": {}, "ContainElement": {}, "ContainElements": {}, "ContainSubstring": {},
+	"Equal": {}, "HaveCap": {}, "HaveEach": {}, "HaveExactElements": {}, "HaveExistingField": {},
+	"HaveField": {}, "HaveHTTPBody": {}, "HaveHTTPHeaderWithValue": {}, "HaveHTTPStatus": {}, "HaveKey": {},
+	"HaveKeyWithValue": {}, "HaveLen": {}, "HaveOccurred": {}, "HavePrefix": {}, "HaveSuffix": {},
+	"HaveValue": {}, "MatchError": {}, "MatchJSON": {}, "MatchRegexp": {}, "MatchXML": {},
+	"MatchYAML": {}, "Or": {}, "Panic": {}, "PanicWith": {}, "Receive": {},
+	"Satisfy": {}, "SatisfyAll": {}, "SatisfyAny": {}, "Succeed": {}, "WithTransform": {},
+}
+
+// IsMatcherName checks if the name is the name of a gomega matcher. The Not matcher is not
+// included, because it is a wrapper of another matcher.
+func IsMatcherName(name string) bool {
+	_, ok := matcherNames[name]
+	return ok
+}
@@ -56,6 +56,12 @@ func (b *Builder) SetFixOffer(fixOffer ast.Expr) {
 	}
 }
 
+// SetFixEnd sets the end of the code that the suggested fix replaces, when the fix also replaces the
+// code after the reported expression; e.g. when it merges two assertions into one
+func (b *Builder) SetFixEnd(end token.Pos) {
+	b.end = end
+}
+
 func (b *Builder) HasReport() bool {
 	return len(b.issues) > 0
 }
@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const assertionStyleTemplate = "wrong assertion style; use %s(...).%s(...), according to the %s assertion style"
+
+// assertionStyles maps the actual functions and the assertion methods, to the ones of each style
+var assertionStyles = map[types.AssertionStyle]struct {
+	actual     map[string]string
+	assertions map[string]string
+}{
+	types.AssertionStyleExpect: {
+		actual:     map[string]string{"Ω": "Expect", "Expect": "Expect", "ExpectWithOffset": "ExpectWithOffset"},
+		assertions: map[string]string{"Should": "To", "ShouldNot": "ToNot"},
+	},
+	types.AssertionStyleOmega: {
+		// there is no Ω function with offset, so ExpectWithOffset is not changed
+		actual:     map[string]string{"Expect": "Ω", "Ω": "Ω"},
+		assertions: map[string]string{"To": "Should", "ToNot": "ShouldNot", "NotTo": "ShouldNot"},
+	},
+}
+
+// AssertionStyleRule enforces one style of the synchronous assertions: either `Expect(x).To(...)`,
+// or `Ω(x).Should(...)`, and suggests replacing the actual function and the assertion method, to
+// match the configured style.
+type AssertionStyleRule struct{}
+
+func (AssertionStyleRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.AssertionStyle != "" && !gexp.IsAsync()
+}
+
+func (r AssertionStyleRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	style, ok := assertionStyles[config.AssertionStyle]
+	if !ok {
+		return false
+	}
+
+	actualName, ok := style.actual[gexp.GetActualFuncName()]
+	if !ok {
+		return false
+	}
+
+	assertionName := gexp.GetAssertFuncName()
+	if name, ok := style.assertions[assertionName]; ok {
+		assertionName = name
+	}
+
+	if actualName == gexp.GetActualFuncName() && assertionName == gexp.GetAssertFuncName() {
+		return false
+	}
+
+	if actualName != gexp.GetActualFuncName() {
+		gexp.ReplaceActualFuncName(actualName)
+	}
+	gexp.ReplaceAssertionMethod(assertionName)
+
+	reportBuilder.AddIssue(true, assertionStyleTemplate, actualName, assertionName, config.AssertionStyle)
+
+	// always return false, to keep checking another rules.
+	return false
+}
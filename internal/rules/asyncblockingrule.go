@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const asyncBlockingTemplate = "the function of %s only runs %s, that blocks the polling; gomega can't check the timeout or the polling interval while the function is blocked; use a channel directly as the actual value, or a non-blocking function"
+
+// AsyncBlockingRule finds Eventually and Consistently assertions, which their actual value is a
+// function literal, that only runs a known blocking operation; e.g.
+//
+//	Consistently(func() int { return <-ch }).Should(Equal(1))
+//
+// Gomega calls the function on each polling, and waits for it to return; so a blocking function
+// defeats the timeout and the consistency window.
+//
+// This rule does not offer an auto fix.
+type AsyncBlockingRule struct{}
+
+func (AsyncBlockingRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !config.ForbidAsyncBlocking {
+		return false
+	}
+
+	asyncArg := gexp.GetAsyncActualArg()
+	if asyncArg == nil {
+		return false
+	}
+
+	blocking, ok := asyncArg.GetBlockingCall()
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, asyncBlockingTemplate, gexp.GetActualFuncName(), blocking)
+
+	// always return false, to keep checking another rules.
+	return false
+}
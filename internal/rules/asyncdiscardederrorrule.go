@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const asyncDiscardedErrorTemplate = "the function of %s discards the error of %s; an error in the polled function is silently ignored; return the error and use the Succeed matcher, or get a Gomega argument and assert it with `g.Expect(err).ToNot(HaveOccurred())`"
+
+// AsyncDiscardedErrorRule finds Eventually and Consistently assertions, which their actual value is
+// a function literal, that assigns the error of a call to the blank identifier; e.g.
+//
+//	Eventually(func() int { v, _ := f(); return v }).Should(Equal(1))
+//
+// should be:
+//
+//	Eventually(func(g Gomega) int {
+//		v, err := f()
+//		g.Expect(err).ToNot(HaveOccurred())
+//		return v
+//	}).Should(Equal(1))
+//
+// This rule does not offer an auto fix.
+type AsyncDiscardedErrorRule struct{}
+
+func (AsyncDiscardedErrorRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !config.ForbidAsyncDiscardedError {
+		return false
+	}
+
+	asyncArg := gexp.GetAsyncActualArg()
+	if asyncArg == nil {
+		return false
+	}
+
+	call, ok := asyncArg.GetDiscardedErrorCall()
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, asyncDiscardedErrorTemplate, gexp.GetActualFuncName(), reportBuilder.FormatExpr(call))
+
+	// always return false, to keep checking another rules.
+	return false
+}
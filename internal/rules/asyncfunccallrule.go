@@ -6,7 +6,10 @@ import (
 	"github.com/nunnatsa/ginkgolinter/types"
 )
 
-const valueInEventually = "use a function call in %[1]s. This actually checks nothing, because %[1]s receives the function returned value, instead of function itself, and this value is never changed"
+const (
+	valueInEventually      = "use a function call in %[1]s. This actually checks nothing, because %[1]s receives the function returned value, instead of function itself, and this value is never changed"
+	fixedValueInEventually = "%[1]s receives a value, that is not a function, a channel or a pointer. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead"
+)
 
 // AsyncFuncCallRule checks that there is no function call actual parameter,
 // in an async actual method (e.g. Eventually).
@@ -16,26 +19,28 @@ const valueInEventually = "use a function call in %[1]s. This actually checks no
 // returned before calling the assertion.
 //
 // We do allow functions that return a function, a channel or a pointer.
+//
+// The rule also finds async actual methods with a plain value, that is not a function call, like
+// `Eventually(x)`, when x is an int. There is no fix for this case.
 type AsyncFuncCallRule struct{}
 
 func (r AsyncFuncCallRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
-	if config.SuppressAsync || !gexp.IsAsync() {
-		return false
-	}
-
-	if asyncArg := gexp.GetAsyncActualArg(); asyncArg != nil {
-		return !asyncArg.IsValid()
-	}
-
-	return false
+	return !config.SuppressAsync && gexp.IsAsync() && gexp.GetAsyncActualArg() != nil
 }
 
 func (r AsyncFuncCallRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
-	if r.isApplied(gexp, config) {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
 
+	asyncArg := gexp.GetAsyncActualArg()
+	if !asyncArg.IsValid() {
 		gexp.AppendWithArgsToActual()
 
 		reportBuilder.AddIssue(true, valueInEventually, gexp.GetActualFuncName())
+	} else if asyncArg.IsFixedValue() {
+		reportBuilder.AddIssue(false, fixedValueInEventually, gexp.GetActualFuncName())
 	}
+
 	return false
 }
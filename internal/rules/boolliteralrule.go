@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	boolLiteralAlwaysPass = "asserting the %t literal; this assertion always passes, and so it checks nothing"
+	boolLiteralAlwaysFail = "asserting the %t literal; this assertion always fails"
+	boolLiteralActual     = "asserting the %t literal; this assertion does not check any code"
+)
+
+// BoolLiteralRule finds assertions of a boolean literal, like `Expect(true).To(BeTrue())`. Such
+// assertions are a tautology or a contradiction, and they do not test anything.
+type BoolLiteralRule struct{}
+
+func (BoolLiteralRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidBoolLiteral && gexp.ActualArgTypeIs(actual.BoolLiteralArgType)
+}
+
+func (r BoolLiteralRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	val := gexp.GetActualArg().(*actual.BoolLiteralPayload).GetBoolValue()
+
+	if !gexp.MatcherTypeIs(matcher.BoolValueTrue | matcher.BoolValueFalse) {
+		reportBuilder.AddIssue(false, boolLiteralActual, val)
+		return true
+	}
+
+	passes := val == gexp.MatcherTypeIs(matcher.BoolValueTrue)
+	if gexp.IsNegativeAssertion() {
+		passes = !passes
+	}
+
+	if passes {
+		reportBuilder.AddIssue(false, boolLiteralAlwaysPass, val)
+	} else {
+		reportBuilder.AddIssue(false, boolLiteralAlwaysFail, val)
+	}
+
+	return true
+}
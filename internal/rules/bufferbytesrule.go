@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const bufferBytesTemplate = "comparing the bytes of a bytes.Buffer; compare its String() instead, for a readable failure message (or use MatchJSON for a JSON content)"
+
+// BufferBytesRule finds Equal assertions of the Bytes() method of a bytes.Buffer, like
+// `Expect(buf.Bytes()).To(Equal([]byte("x")))`, and suggests comparing the String() of the buffer,
+// like `Expect(buf.String()).To(Equal("x"))`, because the failure message of byte slices is hard to
+// read.
+type BufferBytesRule struct{}
+
+func (BufferBytesRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidBufferBytes && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r BufferBytesRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	recv, ok := gexp.GetActualBufferBytesReceiver()
+	if !ok {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok {
+		return false
+	}
+
+	gexp.ReplaceActual(&ast.CallExpr{
+		Fun: &ast.SelectorExpr{X: recv, Sel: ast.NewIdent("String")},
+	})
+	gexp.SetMatcherEqual(r.toString(mtchr.GetValueExpr()))
+
+	reportBuilder.AddIssue(true, bufferBytesTemplate)
+
+	return true
+}
+
+// toString returns the string literal, if the expression is a conversion of a string literal to
+// a byte slice, like `[]byte("x")`, or a conversion of the expression to a string otherwise
+func (BufferBytesRule) toString(expr ast.Expr) ast.Expr {
+	if conv, ok := expr.(*ast.CallExpr); ok && len(conv.Args) == 1 {
+		if _, ok := conv.Fun.(*ast.ArrayType); ok {
+			if lit, ok := conv.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				return lit
+			}
+		}
+	}
+
+	return &ast.CallExpr{
+		Fun:  ast.NewIdent("string"),
+		Args: []ast.Expr{expr},
+	}
+}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"go/token"
+	gotypes "go/types"
 
 	"github.com/nunnatsa/ginkgolinter/internal/expression"
 	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
@@ -10,10 +11,19 @@ import (
 	"github.com/nunnatsa/ginkgolinter/types"
 )
 
-const wrongCapWarningTemplate = "wrong cap assertion"
+const (
+	wrongCapWarningTemplate = "wrong cap assertion"
+	haveCapZeroTemplate     = "wrong cap assertion; a zero capacity is rarely meaningful, because it only means an unbuffered channel, or a slice with no allocated space; if the length is meant, use BeEmpty() instead"
+)
 
 // CapRule does not allow using the cap() function in actual with numeric comparison.
-// it suggests to use the HaveLen matcher, instead.
+// it suggests to use the HaveCap matcher, instead.
+//
+// gomega has no matcher for a zero capacity, like BeEmpty for the length, so a comparison of the
+// capacity to zero, like `Expect(cap(x) == 0).To(BeTrue())`, is fixed to `Expect(cap(x)).To(BeZero())`.
+//
+// The rule also warns about the HaveCap(0) matcher, unless the allow-havecap-0 flag is set. This
+// warning has no auto fix, because the author should reconsider what is really asserted.
 type CapRule struct{}
 
 func (r *CapRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
@@ -22,6 +32,11 @@ func (r *CapRule) Apply(gexp *expression.GomegaExpression, config types.Config,
 		return false
 	}
 
+	if gexp.MatcherTypeIs(matcher.HaveCapZeroMatcherType) {
+		reportBuilder.AddIssue(false, haveCapZeroTemplate)
+		return true
+	}
+
 	if r.fixExpression(gexp) {
 		reportBuilder.AddIssue(true, wrongCapWarningTemplate)
 		return true
@@ -34,6 +49,10 @@ func (r *CapRule) isApplied(gexp *expression.GomegaExpression, config types.Conf
 		return false
 	}
 
+	if gexp.MatcherTypeIs(matcher.HaveCapZeroMatcherType) {
+		return !config.AllowHaveCap0 && r.hasCap(gexp.GetActualArgGOType())
+	}
+
 	//matcherType := gexp.matcher.GetMatcherInfo().Type()
 	if gexp.ActualArgTypeIs(actual.CapFuncActualArgType) {
 		if gexp.MatcherTypeIs(matcher.EqualMatcherType | matcher.BeZeroMatcherType) {
@@ -53,6 +72,20 @@ func (r *CapRule) isApplied(gexp *expression.GomegaExpression, config types.Conf
 	return false
 }
 
+// hasCap checks if the type is a slice, an array or a channel, that have a capacity
+func (r *CapRule) hasCap(t gotypes.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch t.Underlying().(type) {
+	case *gotypes.Slice, *gotypes.Array, *gotypes.Chan:
+		return true
+	}
+
+	return false
+}
+
 func (r *CapRule) fixExpression(gexp *expression.GomegaExpression) bool {
 	if gexp.ActualArgTypeIs(actual.CapFuncActualArgType) {
 		return r.fixEqual(gexp)
@@ -96,8 +129,13 @@ func (r *CapRule) fixComparison(gexp *expression.GomegaExpression) bool {
 		return false
 	}
 
-	gexp.SetMatcherCap(actl.GetValueExpr())
-	gexp.ReplaceActual(actl.GetFuncArg())
+	if actl.IsValueZero() {
+		gexp.SetMatcherBeZero()
+		gexp.ReplaceActual(actl.GetLeft().GetValueExpr())
+	} else {
+		gexp.SetMatcherCap(actl.GetValueExpr())
+		gexp.ReplaceActual(actl.GetFuncArg())
+	}
 
 	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
 		gexp.ReverseAssertionFuncLogic()
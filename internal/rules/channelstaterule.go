@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const channelStateTemplate = "asserting the length of the %[1]s channel is racy, because the channel state may change concurrently; use Eventually or Consistently instead; e.g. `Eventually(%[1]s).Should(Receive())`"
+
+// channelStateMatchers are the matchers that check the length of a channel actual
+var channelStateMatchers = map[string]bool{
+	"HaveLen": true,
+	"BeEmpty": true,
+}
+
+// ChannelStateRule finds synchronous assertions of the length of a channel; e.g.
+// `Expect(len(ch)).To(Equal(1))` or `Expect(ch).To(HaveLen(1))`. The channel state is time
+// dependent, so these assertions are flaky, if the channel is used concurrently.
+//
+// This rule does not offer an auto fix.
+type ChannelStateRule struct{}
+
+func (ChannelStateRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidChannelLenAssertion && !gexp.IsAsync()
+}
+
+func (r ChannelStateRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	ch, ok := r.getChannel(gexp)
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, channelStateTemplate, reportBuilder.FormatExpr(ch))
+
+	return true
+}
+
+// getChannel returns the channel expression, if the actual value is `len(ch)`, or if the actual
+// value is a channel, and the matcher checks its length
+func (ChannelStateRule) getChannel(gexp *expression.GomegaExpression) (ast.Expr, bool) {
+	if arg, ok := gexp.GetActualArg().(*actual.FuncCallArgPayload); ok {
+		if arg.ArgType().Is(actual.LenFuncActualArgType) && arg.IsChan() {
+			return arg.GetOrigArg(), true
+		}
+		return nil, false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return nil, false
+	}
+
+	if _, ok := actualType.Underlying().(*gotypes.Chan); !ok || !channelStateMatchers[gexp.GetMatcherInfo().MatcherName()] {
+		return nil, false
+	}
+
+	return gexp.GetOrigActualArgExpr(), true
+}
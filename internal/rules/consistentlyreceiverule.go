@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const consistentlyReceiveTemplate = "the function of %s receives from a channel, in %s; the function is called on each polling, so every call drains another value from the channel; receive the value once, before the assertion, or use the channel directly as the actual value"
+
+// ConsistentlyReceiveRule finds Consistently assertions, which their actual value is a function
+// literal, that receives from a channel; e.g.
+//
+//	Consistently(func() int { return <-ch }).Should(Equal(1))
+//
+// Consistently calls the function repeatedly during the whole consistency window, and so the
+// function drains the channel as a side effect.
+//
+// This rule does not offer an auto fix.
+type ConsistentlyReceiveRule struct{}
+
+func (ConsistentlyReceiveRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !config.ForbidConsistentlyReceive {
+		return false
+	}
+
+	if name := gexp.GetActualFuncName(); name != "Consistently" && name != "ConsistentlyWithOffset" {
+		return false
+	}
+
+	asyncArg := gexp.GetAsyncActualArg()
+	if asyncArg == nil {
+		return false
+	}
+
+	recv, ok := asyncArg.GetChannelReceive()
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, consistentlyReceiveTemplate, gexp.GetActualFuncName(), reportBuilder.FormatExpr(recv))
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"go/constant"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const constantActualTemplate = "the actual value (%s) is a constant expression, and the expected value is also a constant; this assertion is computed at compile time, and it does not test any code"
+
+// ConstantActualRule finds assertions of an arithmetic expression of constants, like
+// `Expect(2 + 2).To(Equal(4))`, when the expected value is also a constant. These assertions check
+// the compiler arithmetic, and not the tested code.
+//
+// This rule does not offer an auto fix.
+type ConstantActualRule struct{}
+
+func (ConstantActualRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	if !gexp.IsActualConstantCalc() {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(interface{ GetValue() constant.Value })
+	if !ok || mtchr.GetValue() == nil {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, constantActualTemplate, gotypes.ExprString(gexp.GetOrigActualArgExpr()))
+
+	return true
+}
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const containElementFieldTemplate = "ContainElement(Equal()) of a struct literal requires all the fields of the element to match; to find the element by its distinguishing field, consider using HaveField; e.g. `ContainElement(HaveField(%q, %s))`"
+
+// ContainElementFieldRule finds ContainElement assertions, with a nested Equal matcher of a struct
+// literal; e.g. `Expect(users).To(ContainElement(Equal(User{Name: "a", Age: 3})))`. All the fields
+// of the element must be equal to the literal, while the test usually only looks for the element
+// with a distinguishing field. The rule suggests the HaveField matcher, with the first field of the
+// literal.
+//
+// This rule is informational, and does not offer an auto fix.
+type ContainElementFieldRule struct{}
+
+func (ContainElementFieldRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForceContainElementHaveField && gexp.MatcherTypeIs(matcher.ContainElementMatcherType)
+}
+
+func (r ContainElementFieldRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	containElement, ok := gexp.GetMatcherInfo().(*matcher.ContainElementMatcher)
+	if !ok {
+		return false
+	}
+
+	nested := containElement.GetNested()
+	equal, ok := nested.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || equal.GetType() == nil || nested.ShouldReverseLogic() {
+		return false
+	}
+
+	if _, ok := equal.GetType().Underlying().(*gotypes.Struct); !ok {
+		return false
+	}
+
+	lit, ok := ast.Unparen(nested.Orig.Args[0]).(*ast.CompositeLit)
+	if !ok || len(lit.Elts) == 0 {
+		return false
+	}
+
+	field, ok := lit.Elts[0].(*ast.KeyValueExpr)
+	if !ok {
+		return false
+	}
+
+	key, ok := field.Key.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, containElementFieldTemplate, key.Name, reportBuilder.FormatExpr(field.Value))
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	contextDoneReceiveTemplate = "%s is closed when the context is done, and it never receives a value; the Receive matcher fails for a closed channel; use the BeClosed matcher instead"
+	contextDoneMatcherTemplate = "%s is a channel, that is closed when the context is done; assert it with the BeClosed matcher, instead of %s"
+)
+
+// ContextDoneRule finds assertions of the Done() channel of a context, with a matcher that is not
+// BeClosed. The Done() channel never receives a value; it is only closed when the context is done.
+// The Receive matcher fails for a closed channel, so `Eventually(ctx.Done()).Should(Receive())`
+// never passes; e.g.
+//
+//	Eventually(ctx.Done()).Should(Receive())
+//
+// should be:
+//
+//	Eventually(ctx.Done()).Should(BeClosed())
+//
+// The Receive matcher is fixed; other matchers are only reported.
+type ContextDoneRule struct{}
+
+func (ContextDoneRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidContextDoneMisuse && gexp.IsActualContextDone() && !gexp.HasAliasedMatcher()
+}
+
+func (r ContextDoneRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actualExpr := reportBuilder.FormatExpr(gexp.GetOrigActualArgExpr())
+
+	switch matcherName := gexp.GetMatcherInfo().MatcherName(); matcherName {
+	case "BeClosed":
+		return false
+
+	case "Receive":
+		gexp.ReplaceMatcherFuncName("BeClosed")
+		gexp.RemoveMatcherArgs()
+		reportBuilder.AddIssue(true, contextDoneReceiveTemplate, actualExpr)
+
+	default:
+		reportBuilder.AddIssue(false, contextDoneMatcherTemplate, actualExpr, matcherName)
+	}
+
+	return true
+}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const wrongDeepEqualTemplate = "wrong reflect.DeepEqual assertion; use the Equal matcher instead, for a better failure message"
+
+// DeepEqualRule finds boolean assertions of the reflect.DeepEqual function, and suggests using the
+// Equal matcher, that compares the values with reflect.DeepEqual as well; e.g. replace
+// `Expect(reflect.DeepEqual(a, b)).To(BeTrue())` with `Expect(a).To(Equal(b))`
+//
+// The Equal matcher refuses a nil expected value, so the rule does not trigger if one of the
+// arguments of reflect.DeepEqual is nil. This rule is part of the comparison checks, and it is
+// suppressed with them.
+type DeepEqualRule struct{}
+
+func (DeepEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressCompare &&
+		gexp.ActualArgTypeIs(actual.DeepEqualArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r DeepEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	if actl.NumArgs() != 2 || isNilIdent(actl.GetOrigArg(0)) || isNilIdent(actl.GetOrigArg(1)) {
+		return false
+	}
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherEqual(actl.GetArg(1))
+	gexp.ReplaceActual(actl.GetArg(0))
+
+	reportBuilder.AddIssue(true, wrongDeepEqualTemplate)
+
+	return true
+}
+
+func isNilIdent(expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	gotypes "go/types"
+	"time"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const durationLiteralTemplate = "comparing a time.Duration value with a raw nanoseconds literal (%s); use time.Duration units instead; e.g. %s"
+
+// minDurationLiteral is the smallest integer literal that is reported; smaller values are too short
+// to be a real duration, and are probably intentional (e.g. comparing to 0)
+const minDurationLiteral = int64(time.Microsecond)
+
+var durationUnits = []struct {
+	name string
+	dur  time.Duration
+}{
+	{name: "time.Hour", dur: time.Hour},
+	{name: "time.Minute", dur: time.Minute},
+	{name: "time.Second", dur: time.Second},
+	{name: "time.Millisecond", dur: time.Millisecond},
+	{name: "time.Microsecond", dur: time.Microsecond},
+}
+
+// DurationLiteralRule finds BeNumerically assertions of a time.Duration actual, when the expected
+// value is a bare integer literal, that is actually a nanoseconds number; e.g.
+// `Expect(elapsed).To(BeNumerically("<", 1000000000))`, and suggests using time.Duration
+// units, like `time.Second`.
+//
+// This rule only suggests the replacement, but does not offer an auto fix. It is part of the
+// comparison checks, and it is suppressed with them.
+type DurationLiteralRule struct{}
+
+func (DurationLiteralRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if config.SuppressCompare || !gexp.MatcherTypeIs(matcher.BeNumericallyMatcherType) {
+		return false
+	}
+
+	return isDurationType(gexp.GetActualArgGOType())
+}
+
+// isDurationType checks if the type is the time.Duration type, by its package path and name
+func isDurationType(t gotypes.Type) bool {
+	named, ok := t.(*gotypes.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Duration"
+}
+
+func (r DurationLiteralRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.BeNumericallyMatcher)
+	if !ok {
+		return false
+	}
+
+	lit, ok := mtchr.GetValueExpr().(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT || mtchr.GetValue() == nil {
+		return false
+	}
+
+	val, ok := constant.Int64Val(mtchr.GetValue())
+	if !ok || val < minDurationLiteral {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, durationLiteralTemplate, lit.Value, durationUnitsExpr(time.Duration(val)))
+
+	return true
+}
+
+func durationUnitsExpr(dur time.Duration) string {
+	for _, unit := range durationUnits {
+		if dur%unit.dur == 0 {
+			if dur == unit.dur {
+				return unit.name
+			}
+			return fmt.Sprintf("%d * %s", dur/unit.dur, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", dur)
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const elementOfTemplate = "wrong comparison chain assertion"
+
+// ElementOfRule finds boolean assertions of a chain of equality comparisons of the same value,
+// and suggests using the BeElementOf matcher instead; e.g.
+// `Expect(x == a || x == b).To(BeTrue())` => `Expect(x).To(BeElementOf(a, b))`
+type ElementOfRule struct{}
+
+func (r ElementOfRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressCompare &&
+		gexp.ActualArgTypeIs(actual.ElementOfArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r ElementOfRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	arg, ok := gexp.GetActualArg().(*actual.ElementOfPayload)
+	if !ok {
+		return false
+	}
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherBeElementOf(arg.GetElements())
+	gexp.ReplaceActual(arg.GetValue())
+
+	reportBuilder.AddIssue(true, elementOfTemplate)
+
+	return true
+}
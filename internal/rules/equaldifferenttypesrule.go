@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"go/ast"
+	"go/constant"
 	gotypes "go/types"
 
 	"github.com/nunnatsa/ginkgolinter/internal/expression"
@@ -9,7 +11,10 @@ import (
 	"github.com/nunnatsa/ginkgolinter/types"
 )
 
-const compareDifferentTypes = "use %[1]s with different types: Comparing %[2]s with %[3]s; either change the expected value type if possible, or use the BeEquivalentTo() matcher, instead of %[1]s()"
+const (
+	compareDifferentTypes = "use %[1]s with different types: Comparing %[2]s with %[3]s; either change the expected value type if possible, or use the BeEquivalentTo() matcher, instead of %[1]s()"
+	compareUnrelatedTypes = "use %[1]s with unrelated types: Comparing %[2]s with %[3]s; these types can never be equal, so the %[1]s() matcher never matches"
+)
 
 type EqualDifferentTypesRule struct{}
 
@@ -90,13 +95,59 @@ func (r EqualDifferentTypesRule) checkEqualDifferentTypes(gexp *expression.Gomeg
 			return false
 		}
 
-		reportBuilder.AddIssue(false, compareDifferentTypes, matcherName, actualType, matcherType)
+		template := compareDifferentTypes
+		if r.isUnrelated(actualType, matcherType) {
+			template = compareUnrelatedTypes
+		}
+
+		if fix, ok := r.getFixedValue(gexp, mtchr, actualType, parentPointer); ok {
+			gexp.SetMatcherEqual(fix)
+			reportBuilder.AddIssue(true, template, matcherName, actualType, matcherType)
+			return true
+		}
+
+		reportBuilder.AddIssue(false, template, matcherName, actualType, matcherType)
 		return true
 	}
 
 	return false
 }
 
+// getFixedValue returns the expected value, with the type of the actual value, if it is known; i.e.
+// a constant string that is compared with a byte slice, or an integer literal that is compared
+// with a named integer type, that has a constant with the same value. Only the gomega matcher of
+// the assertion is fixed, and not nested matchers.
+func (r EqualDifferentTypesRule) getFixedValue(gexp *expression.GomegaExpression, mtchr *matcher.Matcher, actualType gotypes.Type, parentPointer bool) (ast.Expr, bool) {
+	if parentPointer || mtchr != gexp.GetMatcher() {
+		return nil, false
+	}
+
+	if enumConst, ok := gexp.GetEnumConst(); ok {
+		return enumConst, true
+	}
+
+	return r.getByteSliceValue(mtchr, actualType)
+}
+
+// getByteSliceValue returns the expected value, converted to a byte slice, if the actual value is a
+// byte slice, and the expected value of the Equal matcher is a constant string; e.g.
+// `Expect(b).To(Equal("hello"))` should be `Expect(b).To(Equal([]byte("hello")))`.
+func (EqualDifferentTypesRule) getByteSliceValue(mtchr *matcher.Matcher, actualType gotypes.Type) (ast.Expr, bool) {
+	equal, ok := mtchr.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || equal.GetValue() == nil || equal.GetValue().Kind() != constant.String {
+		return nil, false
+	}
+
+	if !gotypes.Identical(actualType, gotypes.NewSlice(gotypes.Typ[gotypes.Byte])) {
+		return nil, false
+	}
+
+	return &ast.CallExpr{
+		Fun:  &ast.ArrayType{Elt: ast.NewIdent("byte")},
+		Args: []ast.Expr{equal.GetValueExpr()},
+	}, true
+}
+
 func (r EqualDifferentTypesRule) isImplementing(ifs, impl gotypes.Type) bool {
 	if gotypes.IsInterface(ifs) {
 
@@ -117,3 +168,29 @@ func (r EqualDifferentTypesRule) isImplementing(ifs, impl gotypes.Type) bool {
 	}
 	return false
 }
+
+// isUnrelated checks if the two types are fundamentally incompatible, so a value of one type can
+// never be equal to a value of the other, even by using the BeEquivalentTo() matcher, that converts
+// the actual value to the type of the expected one; e.g. bool and int. Interfaces and the untyped
+// nil are never considered as unrelated, because the actual dynamic type is unknown.
+func (r EqualDifferentTypesRule) isUnrelated(t1, t2 gotypes.Type) bool {
+	if t1 == nil || t2 == nil || gotypes.IsInterface(t1) || gotypes.IsInterface(t2) {
+		return false
+	}
+
+	if isUntypedNil(t1) || isUntypedNil(t2) {
+		return false
+	}
+
+	if gotypes.AssignableTo(t1, t2) || gotypes.AssignableTo(t2, t1) {
+		return false
+	}
+
+	// convertible types, like int and string (to a rune string), may match with BeEquivalentTo()
+	return !gotypes.ConvertibleTo(t1, t2) && !gotypes.ConvertibleTo(t2, t1)
+}
+
+func isUntypedNil(t gotypes.Type) bool {
+	basic, ok := t.(*gotypes.Basic)
+	return ok && basic.Kind() == gotypes.UntypedNil
+}
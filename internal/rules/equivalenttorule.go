@@ -0,0 +1,58 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const equivalentToTemplate = "use Equal instead of BeEquivalentTo, to compare values of the same type"
+
+// EquivalentToRule finds BeEquivalentTo assertions, when the actual value and the expected value
+// are of the same type, and suggests using the Equal matcher instead; e.g.
+// `Expect(x).To(BeEquivalentTo(y))` should be `Expect(x).To(Equal(y))`, if both x and y are int.
+//
+// BeEquivalentTo converts the actual value to the type of the expected value, so it is meant for
+// values of different types. Using it with the same types, hides type mismatches that may be
+// introduced later.
+//
+// Interface types are skipped, because their dynamic types may be different. Pointer types are
+// skipped as well, because BeEquivalentTo of two pointers is a common way to compare them.
+type EquivalentToRule struct{}
+
+func (EquivalentToRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressEquivalentTo && gexp.MatcherTypeIs(matcher.BeEquivalentToMatcherType)
+}
+
+func (r EquivalentToRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.BeEquivalentToMatcher)
+	if !ok {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil || mtchr.GetType() == nil || gotypes.IsInterface(actualType) || isPointerType(actualType) {
+		return false
+	}
+
+	if !gotypes.Identical(actualType, mtchr.GetType()) {
+		return false
+	}
+
+	gexp.ReplaceMatcherFuncName("Equal")
+	reportBuilder.AddIssue(true, equivalentToTemplate)
+
+	return true
+}
+
+func isPointerType(t gotypes.Type) bool {
+	_, ok := t.Underlying().(*gotypes.Pointer)
+	return ok
+}
@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const errorsAsInAssertion = "asserting errors.As, that also populates %s as a side effect; consider calling errors.As explicitly before the assertion, or use the MatchError matcher"
+
+// ErrorsAsRule finds boolean assertions of the errors.As function; e.g.
+// `Expect(errors.As(err, &target)).To(BeTrue())`.
+//
+// errors.As populates its target argument, so the assertion has a hidden side effect. The rule does
+// not suggest a fix, because any rewrite would change the semantics of the code.
+type ErrorsAsRule struct{}
+
+func (ErrorsAsRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidErrorsAs &&
+		gexp.ActualArgTypeIs(actual.ErrorsAsArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r ErrorsAsRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	if actl.NumArgs() != 2 {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, errorsAsInAssertion, reportBuilder.FormatExpr(actl.GetOrigArg(1)))
+
+	return true
+}
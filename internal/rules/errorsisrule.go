@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const wrongErrorsIsWarningTemplate = "wrong errors.Is assertion"
+
+// ErrorsIsRule finds boolean assertions of the errors.Is function, and suggests using the MatchError
+// matcher instead; e.g. replace `Expect(errors.Is(err, target)).To(BeTrue())` with
+// `Expect(err).To(MatchError(target))`
+type ErrorsIsRule struct{}
+
+func (ErrorsIsRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if config.SuppressErr {
+		return false
+	}
+
+	return gexp.ActualArgTypeIs(actual.ErrorsIsArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r ErrorsIsRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	if actl.NumArgs() != 2 {
+		return false
+	}
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherMatchError(actl.GetArg(1))
+	gexp.ReplaceActual(actl.GetArg(0))
+
+	reportBuilder.AddIssue(true, wrongErrorsIsWarningTemplate)
+
+	return true
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const errorSliceEqualTemplate = "comparing a slice of errors with %s; the errors are compared with reflect.DeepEqual, that does not support wrapped errors; consider asserting each error with MatchError, e.g. `ContainElement(MatchError(expected))`"
+
+// ErrorSliceEqualRule finds Equal and ConsistOf assertions of a slice of errors; e.g.
+// `Expect(errs).To(Equal([]error{err1, err2}))`. Each error is compared with reflect.DeepEqual,
+// so a wrapped error never matches the expected one.
+//
+// This rule is informational, and does not offer an auto fix.
+type ErrorSliceEqualRule struct{}
+
+func (ErrorSliceEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if !config.ForbidErrorSliceEqual {
+		return false
+	}
+
+	switch gexp.GetMatcherInfo().MatcherName() {
+	case "Equal", "ConsistOf":
+	default:
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	slice, ok := actualType.Underlying().(*gotypes.Slice)
+	return ok && interfaces.ImplementsError(slice.Elem())
+}
+
+func (r ErrorSliceEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, errorSliceEqualTemplate, gexp.GetMatcherInfo().MatcherName())
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const errorStringTemplate = "wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing %s"
+
+// errorStringDescriptions describes how the actual value converts the error to a string, for the
+// report message
+var errorStringDescriptions = map[actual.ErrorStringKind]string{
+	actual.ErrorMethodString:  "its Error() string",
+	actual.SprintfErrorString: "its fmt.Sprintf formatted string",
+}
+
+// ErrorStringRule finds Equal assertions of the Error() string of an error, or of the error
+// formatted by fmt.Sprintf with the "%v" or the "%s" format, with a string expected value, and
+// suggests using the MatchError matcher instead; e.g. replace `Expect(err.Error()).To(Equal("boom"))`
+// or `Expect(fmt.Sprintf("%v", err)).To(Equal("boom"))` with `Expect(err).To(MatchError("boom"))`.
+// The MatchError matcher also fails gracefully if the error is nil, while `err.Error()` panics.
+type ErrorStringRule struct{}
+
+func (ErrorStringRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressErr && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r ErrorStringRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	errExpr, kind := gexp.GetActualErrorString()
+	if kind == actual.NoErrorString {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || mtchr.GetType() == nil {
+		return false
+	}
+
+	if basic, ok := mtchr.GetType().Underlying().(*gotypes.Basic); !ok || basic.Info()&gotypes.IsString == 0 {
+		return false
+	}
+
+	gexp.ReplaceActual(errExpr)
+	gexp.SetMatcherMatchError(mtchr.GetValueExpr())
+
+	reportBuilder.AddIssue(true, errorStringTemplate, errorStringDescriptions[kind])
+
+	return true
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const exactElementsTemplate = "use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element"
+
+// ExactElementsRule finds Equal assertions of a slice actual value, with a slice literal, like
+// `Expect(s).To(Equal([]int{1, 2, 3}))`, and suggests using the HaveExactElements matcher, with the
+// elements of the literal; e.g. `Expect(s).To(HaveExactElements(1, 2, 3))`.
+//
+// Empty literals, literals with keys, literals with elided element types, like `[]Point{{1, 2}}`,
+// and literals with elements that change their type when they are passed separately, like the `1`
+// in `[]int64{1}`, are not reported.
+type ExactElementsRule struct{}
+
+func (ExactElementsRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if !config.ForceExactElements || !gexp.MatcherTypeIs(matcher.EqualMatcherType) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	_, ok := actualType.Underlying().(*gotypes.Slice)
+	return ok
+}
+
+func (r ExactElementsRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || mtchr.GetType() == nil || !gotypes.Identical(mtchr.GetType(), gexp.GetActualArgGOType()) {
+		return false
+	}
+
+	elements, ok := mtchr.GetSliceLiteralElements()
+	if !ok {
+		return false
+	}
+
+	gexp.SetMatcherHaveExactElements(elements)
+	reportBuilder.AddIssue(true, exactElementsTemplate)
+
+	return true
+}
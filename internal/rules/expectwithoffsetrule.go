@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const expectWithOffsetTemplate = "%[1]s is the old offset API; use %[2]s(...).WithOffset(...) instead, or call GinkgoHelper() in the helper function, and use %[2]s"
+
+// withOffsetFuncs maps the actual functions with offset, to the same functions without offset
+var withOffsetFuncs = map[string]string{
+	"ExpectWithOffset":       "Expect",
+	"EventuallyWithOffset":   "Eventually",
+	"ConsistentlyWithOffset": "Consistently",
+}
+
+// ExpectWithOffsetRule finds the actual functions with offset, like `ExpectWithOffset(1, x)`, and
+// suggests the WithOffset method; e.g. `Expect(x).WithOffset(1)`. The fix is only suggested when
+// the offset is an integer literal.
+type ExpectWithOffsetRule struct{}
+
+func (ExpectWithOffsetRule) isApplied(config types.Config) bool {
+	return config.ForbidExpectWithOffset
+}
+
+func (r ExpectWithOffsetRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(config) {
+		return false
+	}
+
+	origName := gexp.GetActualFuncName()
+	newName, ok := withOffsetFuncs[origName]
+	if !ok {
+		return false
+	}
+
+	offset, isLiteral := gexp.GetActualClone().Args[0].(*ast.BasicLit)
+	fixable := isLiteral && offset.Kind == token.INT
+
+	if fixable {
+		gexp.ReplaceActualFuncWithOffset(newName)
+	}
+
+	reportBuilder.AddIssue(fixable, expectWithOffsetTemplate, origName, newName)
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	floatEqualTemplate      = "comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically(\"~\", %s) instead"
+	floatEqualOptInTemplate = "comparing float values with Equal is fragile, because of rounding errors; use BeNumerically(\"~\", %s) instead"
+)
+
+// FloatEqualRule finds Equal assertions of a float actual value, that is computed by arithmetic
+// operations; e.g. `Expect(a / b).To(Equal(0.5))`, and suggests using `BeNumerically("~", ...)`,
+// that tolerates small rounding errors.
+//
+// If the warn-float-equal flag is set, the rule also finds Equal assertions of any float actual
+// value, with a float expected value; e.g. `Expect(x).To(Equal(3.14))`.
+//
+// The tolerance can't be inferred, so this rule only suggests the replacement, but does not offer
+// an auto fix. It is part of the comparison checks, and it is suppressed with them.
+type FloatEqualRule struct{}
+
+func (FloatEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if config.SuppressCompare || !gexp.MatcherTypeIs(matcher.EqualMatcherType) {
+		return false
+	}
+
+	if !gexp.HasActualFloatArithmetic() && !config.WarnFloatEqual {
+		return false
+	}
+
+	return isFloatType(gexp.GetActualArgGOType())
+}
+
+func isFloatType(t gotypes.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	basic, ok := t.Underlying().(*gotypes.Basic)
+	return ok && basic.Info()&gotypes.IsFloat != 0
+}
+
+func (r FloatEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok {
+		return false
+	}
+
+	if gexp.HasActualFloatArithmetic() {
+		reportBuilder.AddIssue(false, floatEqualTemplate, reportBuilder.FormatExpr(mtchr.GetValueExpr()))
+		return true
+	}
+
+	if !isFloatType(mtchr.GetType()) {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, floatEqualOptInTemplate, reportBuilder.FormatExpr(mtchr.GetValueExpr()))
+
+	return true
+}
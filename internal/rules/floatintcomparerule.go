@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"go/constant"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const floatIntCompareTemplate = "comparing a %s actual value with an integer expected value (%s), using %s; integers above 2^53 lose precision when they are converted to float; e.g. JSON numbers that are unmarshalled into an interface{} value are float64; consider unmarshalling into an integer field, or using json.Number"
+
+// FloatIntCompareRule finds Equal and BeNumerically assertions of a float actual value, with a
+// non-constant integer expected value; e.g. `Expect(obj["id"].(float64)).To(BeNumerically("==", id))`,
+// when id is an int64. Large integers can't be represented exactly as float64, and so the
+// comparison may succeed or fail unexpectedly.
+//
+// This rule is informational, and does not offer an auto fix.
+type FloatIntCompareRule struct{}
+
+func (FloatIntCompareRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidFloatIntCompare && gexp.MatcherTypeIs(matcher.EqualMatcherType|matcher.BeNumericallyMatcherType)
+}
+
+func (r FloatIntCompareRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if !r.isBasicKind(actualType, gotypes.IsFloat) {
+		return false
+	}
+
+	var (
+		expectedType  gotypes.Type
+		expectedValue constant.Value
+		matcherName   string
+	)
+
+	switch mtchr := gexp.GetMatcherInfo().(type) {
+	case *matcher.EqualMatcher:
+		expectedType, expectedValue, matcherName = mtchr.GetType(), mtchr.GetValue(), mtchr.MatcherName()
+	case *matcher.BeNumericallyMatcher:
+		expectedType, expectedValue, matcherName = mtchr.GetType(), mtchr.GetValue(), mtchr.MatcherName()
+	default:
+		return false
+	}
+
+	if expectedValue != nil || !r.isBasicKind(expectedType, gotypes.IsInteger) {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, floatIntCompareTemplate, actualType.String(), expectedType.String(), matcherName)
+
+	// always return false, to keep checking another rules.
+	return false
+}
+
+// isBasicKind checks if the type is a typed basic type, with the given info flag; e.g. a float
+func (FloatIntCompareRule) isBasicKind(t gotypes.Type, info gotypes.BasicInfo) bool {
+	if t == nil {
+		return false
+	}
+
+	basic, ok := t.Underlying().(*gotypes.Basic)
+	return ok && basic.Info()&info != 0 && basic.Info()&gotypes.IsUntyped == 0
+}
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const forceExpectTemplate = "must not use Ω; use Expect instead"
+
+// ForceExpectRule forces using Expect as the actual function of the synchronous assertions; e.g.
+// replace `Ω(x).Should(Equal(5))` with `Expect(x).Should(Equal(5))`. Only the actual function is
+// replaced; the assertion method is kept as is.
+//
+// The async assertions have no Ω variant, so they are not checked. The rule is ignored if the
+// assertion style is set, because the assertion style rule already replaces the actual function.
+type ForceExpectRule struct{}
+
+func (ForceExpectRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForceExpect && config.AssertionStyle == "" && gexp.GetActualFuncName() == "Ω"
+}
+
+func (r ForceExpectRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	gexp.ReplaceActualFuncName("Expect")
+	reportBuilder.AddIssue(true, forceExpectTemplate)
+
+	// always return false, to keep checking another rules.
+	return false
+}
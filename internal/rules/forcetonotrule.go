@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const forceToNotTemplate = "use %s instead of wrapping the matcher with Not"
+
+// ForceToNotRule suggests moving the negation from the Not() matcher to the assertion method; e.g.
+// replace `Expect(x).To(Not(BeNil()))` with `Expect(x).ToNot(BeNil())`. It also handles the
+// reverse case, where the Not() matcher is used with a negative assertion method; e.g.
+// `Expect(x).ToNot(Not(BeNil()))` is replaced with `Expect(x).To(BeNil())`.
+//
+// The Not() matcher is already removed from the fix suggestion when parsing the expression,
+// so this rule only reports it.
+type ForceToNotRule struct{}
+
+func (ForceToNotRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForceToNot && gexp.GetMatcher().IsWrappedWithNot()
+}
+
+func (r ForceToNotRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	reportBuilder.AddIssue(true, forceToNotTemplate, gexp.GetAssertFuncName())
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"go/token"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const incomparableTemplate = "comparing values of the incomparable type %s panics at runtime; use the Equal matcher instead"
+
+// IncomparableRule finds equality comparisons of values of an incomparable type, like slices,
+// maps or functions, in the actual value; e.g. `Expect(any(s1) == any(s2)).To(BeTrue())`. Such a
+// comparison compiles, because the values are converted to interfaces, but it panics at runtime.
+// The Equal matcher compares the values with reflect.DeepEqual, and so it does not panic.
+type IncomparableRule struct{}
+
+func (IncomparableRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	if !gexp.ActualArgTypeIs(actual.ComparisonActualArgType) {
+		return false
+	}
+
+	payload, ok := gexp.GetActualArg().(*actual.ComparisonArgPayload)
+	if !ok {
+		return false
+	}
+
+	t, ok := payload.GetIncomparableType()
+	if !ok {
+		return false
+	}
+
+	// the comparison rule would suggest BeIdenticalTo for interfaces, that also panics for these
+	// values, so the fix uses the Equal matcher here
+	if payload.GetOp() == token.NEQ {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherEqual(payload.GetRight().GetValueExpr())
+	gexp.ReplaceActual(payload.GetLeft().GetValueExpr())
+
+	reportBuilder.AddIssue(true, incomparableTemplate, t)
+
+	return true
+}
@@ -0,0 +1,57 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	largeArrayEqualTemplate = "comparing an array of %d elements using Equal; the array is copied by value into the assertion; consider comparing slices of the arrays (e.g. `Expect(arr[:]).To(Equal(other[:]))`), or pointers to them"
+
+	defaultLargeArrayLen = 1024
+)
+
+// LargeArrayEqualRule finds Equal assertions of an array actual value, that is longer than the
+// configured length; e.g. `Expect(arr).To(Equal(other))`, when arr is a `[4096]byte`. Both the
+// actual and the expected arrays are copied by value, when they are passed to gomega.
+//
+// This rule is informational, and does not offer an auto fix.
+type LargeArrayEqualRule struct{}
+
+func (LargeArrayEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidLargeArrayEqual && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r LargeArrayEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	arr, ok := actualType.Underlying().(*gotypes.Array)
+	if !ok {
+		return false
+	}
+
+	maxLen := int64(config.LargeArrayLen)
+	if maxLen <= 0 {
+		maxLen = defaultLargeArrayLen
+	}
+
+	if arr.Len() <= maxLen {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, largeArrayEqualTemplate, arr.Len())
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -97,7 +97,14 @@ func (r *LenRule) fixEqual(gexp *expression.GomegaExpression) bool {
 
 func (r *LenRule) fixComparison(gexp *expression.GomegaExpression) bool {
 	actl := gexp.GetActualArg().(*actual.FuncComparisonPayload)
-	if op := actl.GetOp(); op == token.NEQ {
+	op := actl.GetOp()
+	if op == token.NEQ {
+		gexp.ReverseAssertionFuncLogic()
+	} else if op == token.GTR || op == token.GEQ {
+		// `len(x) > 0` or `len(x) >= 1`
+		if !actl.ArgType().Is(actual.GreaterThanZero) {
+			return false
+		}
 		gexp.ReverseAssertionFuncLogic()
 	} else if op != token.EQL {
 		return false
@@ -107,7 +114,7 @@ func (r *LenRule) fixComparison(gexp *expression.GomegaExpression) bool {
 		gexp.ReverseAssertionFuncLogic()
 	}
 
-	if actl.IsValueZero() {
+	if actl.ArgType().Is(actual.GreaterThanZero) || actl.IsValueZero() {
 		gexp.SetMatcherBeEmpty()
 	} else {
 		gexp.SetMatcherLen(actl.GetValueExpr())
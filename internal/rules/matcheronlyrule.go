@@ -5,6 +5,7 @@ var matcherOnlyRules = Rules{
 	&EqualBoolRule{},
 	&EqualNilRule{},
 	&DoubleNegativeRule{},
+	&SprintfNoVerbsRule{},
 }
 
 func getMatcherOnlyRules() Rules {
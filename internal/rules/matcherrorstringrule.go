@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const matchErrorStringTemplate = "asserting an error as a string, with %s; assert the error itself, and wrap the matcher with MatchError, for a better failure message"
+
+// matchErrorStringMatchers are the string matchers, that can be wrapped with the MatchError matcher
+var matchErrorStringMatchers = map[string]bool{
+	"ContainSubstring": true,
+	"Equal":            true,
+	"MatchRegexp":      true,
+	"HavePrefix":       true,
+	"HaveSuffix":       true,
+}
+
+// MatchErrorStringRule finds assertions of the Error() string of an error, or of the error
+// formatted by fmt.Sprintf with the "%v" or the "%s" format, with a string matcher, and suggests
+// wrapping the matcher with the MatchError matcher; e.g. replace
+// `Expect(err.Error()).To(ContainSubstring("foo"))` with
+// `Expect(err).To(MatchError(ContainSubstring("foo")))`
+//
+// Equal assertions with a string are already fixed to `MatchError(string)` by the ErrorStringRule,
+// so this rule only wraps an Equal matcher, if that rule did not fix it.
+type MatchErrorStringRule struct{}
+
+func (MatchErrorStringRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForceMatchErrorStringMatcher && matchErrorStringMatchers[gexp.GetMatcherInfo().MatcherName()]
+}
+
+func (r MatchErrorStringRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	errExpr, kind := gexp.GetActualErrorString()
+	if kind == actual.NoErrorString {
+		return false
+	}
+
+	gexp.ReplaceActual(errExpr)
+	gexp.WrapMatcherWithMatchError()
+
+	reportBuilder.AddIssue(true, matchErrorStringTemplate, gexp.GetMatcherInfo().MatcherName())
+
+	return true
+}
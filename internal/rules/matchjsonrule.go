@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/constant"
+	gotypes "go/types"
+	"strings"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const matchJSONTemplate = "use MatchJSON to compare JSON values; the Equal matcher is sensitive to white spaces and to the order of the keys"
+
+// MatchJSONRule finds Equal matchers with a JSON object or array string constant, when the actual
+// value is a string or a byte slice, and suggests the MatchJSON matcher instead; e.g.
+// `Expect(string(data)).To(Equal(`{"a": 1}`))` => `Expect(data).To(MatchJSON(`{"a": 1}`))`
+//
+// This rule only suggests the replacement, but does not offer an auto fix; except when the expected
+// value is a conversion of the JSON constant to a byte slice type, like json.RawMessage; e.g.
+// `Expect(raw).To(Equal(json.RawMessage(`{"a": 1}`)))` => `Expect(raw).To(MatchJSON(`{"a": 1}`))`
+type MatchJSONRule struct{}
+
+func (r MatchJSONRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForceMatchJSON && gexp.MatcherTypeIs(matcher.EqualMatcherType) && isStringOrBytes(gexp.GetActualArgGOType())
+}
+
+func (r MatchJSONRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok {
+		return false
+	}
+
+	val := mtchr.GetValue()
+	convVal, convArg, isConversion := mtchr.GetBytesConversion()
+	if isConversion {
+		val = convVal
+	}
+
+	if val == nil || val.Kind() != constant.String || !isJSONLiteral(constant.StringVal(val)) {
+		return false
+	}
+
+	if isConversion {
+		gexp.ReplaceMatcherFuncName("MatchJSON")
+		gexp.ReplaceMatcherArgs([]ast.Expr{convArg})
+	}
+
+	reportBuilder.AddIssue(isConversion, matchJSONTemplate)
+
+	return true
+}
+
+func isStringOrBytes(t gotypes.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch typ := t.Underlying().(type) {
+	case *gotypes.Basic:
+		return typ.Info()&gotypes.IsString != 0
+	case *gotypes.Slice:
+		elem, ok := typ.Elem().Underlying().(*gotypes.Basic)
+		return ok && elem.Kind() == gotypes.Byte
+	}
+
+	return false
+}
+
+// isJSONLiteral checks if the string is a valid JSON object or array. Other JSON values, like
+// numbers or quoted strings, are not considered as JSON, because they are usually just values.
+func isJSONLiteral(s string) bool {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") && !strings.HasPrefix(s, "[") {
+		return false
+	}
+
+	return json.Valid([]byte(s))
+}
@@ -0,0 +1,31 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const methodExpressionTemplate = "the actual value (%s) is a method expression, that is not bound to any receiver; this is probably a mistake; use a method value of a specific receiver, or call the method"
+
+// MethodExpressionRule finds assertions of a method expression, like `Expect(T.Method)`, instead of
+// a method value, like `Expect(t.Method)`, or a method call, like `Expect(t.Method())`.
+//
+// This rule does not offer an auto fix.
+type MethodExpressionRule struct{}
+
+func (MethodExpressionRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidMethodExpression && gexp.IsActualMethodExpression()
+}
+
+func (r MethodExpressionRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, methodExpressionTemplate, gotypes.ExprString(gexp.GetOrigActualArgExpr()))
+
+	return true
+}
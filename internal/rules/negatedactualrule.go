@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const negatedActualTemplate = "avoid negating the actual value of a boolean assertion"
+
+// NegatedActualRule finds boolean assertions of a negated actual value, like
+// `Expect(!ready).To(BeTrue())`, that should be `Expect(ready).To(BeFalse())`. A double negation,
+// like `!!ready`, is removed without changing the matcher.
+type NegatedActualRule struct{}
+
+func (NegatedActualRule) isApplied(gexp *expression.GomegaExpression) bool {
+	if !gexp.MatcherTypeIs(matcher.BeTrueMatcherType | matcher.BeFalseMatcherType) {
+		return false
+	}
+
+	return isNegation(ast.Unparen(gexp.GetActualArgExpr()))
+}
+
+func (r NegatedActualRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp) {
+		return false
+	}
+
+	negated := false
+	expr := ast.Unparen(gexp.GetActualArgExpr())
+	for isNegation(expr) {
+		negated = !negated
+		expr = ast.Unparen(expr.(*ast.UnaryExpr).X)
+	}
+
+	gexp.ReplaceActual(expr)
+
+	if negated {
+		if gexp.MatcherTypeIs(matcher.BeTrueMatcherType) {
+			gexp.SetMatcherBeFalse()
+		} else {
+			gexp.SetMatcherBeTrue()
+		}
+	}
+
+	reportBuilder.AddIssue(true, negatedActualTemplate)
+
+	return true
+}
+
+func isNegation(expr ast.Expr) bool {
+	unary, ok := expr.(*ast.UnaryExpr)
+	return ok && unary.Op == token.NOT
+}
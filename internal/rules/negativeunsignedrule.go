@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"go/constant"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const negativeUnsignedTemplate = "comparing an unsigned value of type %s with a negative constant (%s); an unsigned value is never negative, so the result of this assertion is always the same"
+
+// NegativeUnsignedRule finds Equal and BeNumerically matchers with a negative constant, when the
+// actual value is unsigned; e.g. `Expect(u).To(BeNumerically(">", -1))` is always true, when u is
+// an uint.
+type NegativeUnsignedRule struct{}
+
+func (NegativeUnsignedRule) isApplied(gexp *expression.GomegaExpression) bool {
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	basic, ok := actualType.Underlying().(*gotypes.Basic)
+	return ok && basic.Info()&gotypes.IsUnsigned != 0
+}
+
+func (r NegativeUnsignedRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp) {
+		return false
+	}
+
+	var val constant.Value
+	switch mtchr := gexp.GetMatcherInfo().(type) {
+	case *matcher.EqualMatcher:
+		val = mtchr.GetValue()
+	case *matcher.BeNumericallyMatcher:
+		val = mtchr.GetValue()
+	default:
+		return false
+	}
+
+	if val == nil || (val.Kind() != constant.Int && val.Kind() != constant.Float) || constant.Sign(val) >= 0 {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, negativeUnsignedTemplate, gexp.GetActualArgGOType(), val.ExactString())
+
+	return true
+}
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	nestedAssertionTemplate      = "the actual value is the result of another assertion (%s); gomega already fails the test if this assertion fails, so asserting its result is redundant; use the inner assertion only"
+	asyncNestedAssertionTemplate = "the actual value of %[1]s is the result of an assertion (%[2]s), that runs only once, before %[1]s starts polling; pass a function with a Gomega argument to %[1]s instead; e.g. `%[1]s(func(g Gomega) { g.Expect(...)... }).Should(Succeed())`"
+)
+
+// NestedAssertionRule finds assertions, which their actual value is a complete gomega assertion;
+// e.g. `Expect(Expect(x).To(Equal(y))).To(BeTrue())`. The inner assertion fails the test by itself,
+// and so its boolean result is always true.
+//
+// For async assertions, like `Eventually(Expect(x).To(Equal(y))).Should(BeTrue())`, the inner
+// assertion runs only once, when the actual value is evaluated, and so it is never polled.
+type NestedAssertionRule struct{}
+
+func (NestedAssertionRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	nested, ok := gexp.GetNestedAssertion()
+	if !ok {
+		return false
+	}
+
+	nestedName := nested.GetActualFuncName() + "(...)." + nested.GetOrigAssertFuncName() + "(...)"
+	if gexp.IsAsync() {
+		reportBuilder.AddIssue(false, asyncNestedAssertionTemplate, gexp.GetActualFuncName(), nestedName)
+	} else {
+		reportBuilder.AddIssue(false, nestedAssertionTemplate, nestedName)
+	}
+
+	return true
+}
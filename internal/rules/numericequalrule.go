@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"go/token"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const numericEqualTemplate = `use Equal instead of BeNumerically("==", ...), to compare a non-float value`
+
+// NumericEqualRule finds `BeNumerically("==", value)` assertions of an integer actual value, with a
+// constant expected value of the same type, and suggests the simpler Equal matcher; e.g.
+// `Expect(n).To(BeNumerically("==", 5))` should be `Expect(n).To(Equal(5))`.
+//
+// Float values are skipped, because comparing floats with `==` is discouraged. The len() and cap()
+// actual values are also skipped; they are handled by the length and the capacity rules.
+//
+// This rule is part of the comparison checks, and it is suppressed with them.
+type NumericEqualRule struct{}
+
+func (NumericEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if config.SuppressCompare || !gexp.MatcherTypeIs(matcher.BeNumericallyMatcherType) {
+		return false
+	}
+
+	if gexp.ActualArgTypeIs(actual.LenFuncActualArgType) || gexp.ActualArgTypeIs(actual.CapFuncActualArgType) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	basic, ok := actualType.Underlying().(*gotypes.Basic)
+	return ok && basic.Info()&gotypes.IsInteger != 0
+}
+
+func (r NumericEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.BeNumericallyMatcher)
+	if !ok || mtchr.GetOp() != token.EQL || mtchr.GetValue() == nil {
+		return false
+	}
+
+	// Equal also compares the types, so the expected value must be of the same type as the actual
+	// value; e.g. `Equal(5)` does not match an int64 value
+	if mtchr.GetType() == nil || !gotypes.Identical(mtchr.GetType(), gexp.GetActualArgGOType()) {
+		return false
+	}
+
+	gexp.SetMatcherEqual(mtchr.GetValueExpr())
+	reportBuilder.AddIssue(true, numericEqualTemplate)
+
+	return true
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const osIsErrorTemplate = "os.%s does not support wrapped errors, and new code should use errors.Is instead; use the MatchError matcher, that uses errors.Is, with os.%s"
+
+// osIsErrorTargets maps the os functions to the error that they check
+var osIsErrorTargets = map[string]string{
+	"IsExist":      "ErrExist",
+	"IsNotExist":   "ErrNotExist",
+	"IsPermission": "ErrPermission",
+}
+
+// OsIsErrorRule finds boolean assertions of the os.IsNotExist, os.IsExist and os.IsPermission
+// functions, and suggests using the MatchError matcher instead; e.g. replace
+// `Expect(os.IsNotExist(err)).To(BeTrue())` with `Expect(err).To(MatchError(os.ErrNotExist))`
+type OsIsErrorRule struct{}
+
+func (OsIsErrorRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if config.SuppressErr {
+		return false
+	}
+
+	return gexp.ActualArgTypeIs(actual.OsIsErrorArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r OsIsErrorRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	if actl.NumArgs() != 1 {
+		return false
+	}
+
+	target := osIsErrorTargets[actl.FuncName()]
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherMatchError(r.getTarget(actl.GetFunc(), target))
+	gexp.ReplaceActual(actl.GetArg(0))
+
+	reportBuilder.AddIssue(true, osIsErrorTemplate, actl.FuncName(), target)
+
+	return true
+}
+
+// getTarget returns the os error, using the same package name as the called function; e.g.
+// `os.ErrNotExist` for `os.IsNotExist`
+func (OsIsErrorRule) getTarget(fun ast.Expr, target string) ast.Expr {
+	if sel, ok := fun.(*ast.SelectorExpr); ok {
+		return &ast.SelectorExpr{X: sel.X, Sel: ast.NewIdent(target)}
+	}
+
+	return ast.NewIdent(target)
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const protoEqualTemplate = "comparing a protobuf message (%s) using Equal; protobuf messages hold an internal state, that reflect.DeepEqual compares as well; use a proto-aware matcher instead; e.g. `BeComparableTo(expected, protocmp.Transform())`, or `proto.Equal`"
+
+// ProtoEqualRule finds Equal assertions of a protobuf message; e.g. `Expect(msg).To(Equal(other))`.
+// Gomega uses reflect.DeepEqual, that also compares the internal state of the generated message
+// structs, so equal messages may be found as different.
+//
+// A type is considered as a protobuf message, if it, or a pointer to it, has the
+// `ProtoReflect()` method; i.e. it implements the `proto.Message` interface.
+//
+// This rule is informational, and does not offer an auto fix.
+type ProtoEqualRule struct{}
+
+func (ProtoEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidProtoEqual && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r ProtoEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil || !isProtoMessage(actualType) {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, protoEqualTemplate, gotypes.TypeString(actualType, func(pkg *gotypes.Package) string { return pkg.Name() }))
+
+	// always return false, to keep checking another rules.
+	return false
+}
+
+// isProtoMessage checks if the type has the `ProtoReflect()` method of a protobuf message, with no
+// parameters and with a single result. Interface types are not checked, because the actual
+// message type is not known.
+func isProtoMessage(t gotypes.Type) bool {
+	if gotypes.IsInterface(t) {
+		return false
+	}
+
+	obj, _, _ := gotypes.LookupFieldOrMethod(t, true, nil, "ProtoReflect")
+	fn, ok := obj.(*gotypes.Func)
+	if !ok {
+		return false
+	}
+
+	sig, ok := fn.Type().(*gotypes.Signature)
+	return ok && sig.Params().Len() == 0 && sig.Results().Len() == 1
+}
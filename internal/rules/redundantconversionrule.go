@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"go/ast"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	redundantActualConversion  = "redundant type conversion of the actual value: the value is already of type %s"
+	redundantMatcherConversion = "redundant type conversion in the %s matcher: the value is already of type %s"
+)
+
+// redundantConversionMatchers are the matchers with a single value argument, that this rule checks
+var redundantConversionMatchers = map[string]bool{
+	"Equal":          true,
+	"BeIdenticalTo":  true,
+	"BeEquivalentTo": true,
+}
+
+// RedundantConversionRule finds conversions of values to the type they already have, in the actual
+// value or in the argument of the Equal, BeIdenticalTo or BeEquivalentTo matchers, and suggests
+// removing them; e.g. `Expect(int(x)).To(Equal(int(y)))` => `Expect(x).To(Equal(y))`, when x and y
+// are already of type int.
+//
+// This rule is part of the type comparison checks, and it is suppressed with them.
+type RedundantConversionRule struct{}
+
+func (r RedundantConversionRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if config.SuppressTypeCompare {
+		return false
+	}
+
+	found := false
+
+	if operand, ok := gexp.GetActualRedundantConversionOperand(); ok {
+		gexp.ReplaceActual(operand)
+		reportBuilder.AddIssue(true, redundantActualConversion, gexp.GetActualArgGOType())
+		found = true
+	}
+
+	mtchr := gexp.GetMatcher()
+	matcherName := mtchr.GetMatcherInfo().MatcherName()
+	if operand, ok := mtchr.GetRedundantConversionOperand(); ok && redundantConversionMatchers[matcherName] {
+		conversion := mtchr.Orig.Args[0].(*ast.CallExpr)
+		reportBuilder.AddIssue(true, redundantMatcherConversion, matcherName, reportBuilder.FormatExpr(conversion.Fun))
+		mtchr.Clone.Args[0] = operand
+		found = true
+	}
+
+	return found
+}
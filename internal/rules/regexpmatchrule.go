@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const wrongRegexpMatchTemplate = "wrong %s assertion; use the MatchRegexp matcher instead, for a better failure message"
+
+// RegexpMatchRule finds boolean assertions of the regexp.MatchString function, or of the
+// MatchString method of a regular expression that is compiled by regexp.MustCompile, and suggests
+// using the MatchRegexp matcher instead; e.g. replace `Expect(regexp.MatchString(p, s)).To(BeTrue())`
+// with `Expect(s).To(MatchRegexp(p))`
+//
+// This rule is part of the comparison checks, and it is suppressed with them.
+type RegexpMatchRule struct{}
+
+func (RegexpMatchRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressCompare &&
+		gexp.ActualArgTypeIs(actual.RegexpMatchArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r RegexpMatchRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.RegexpMatchPayload)
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherStringsFunc("MatchRegexp", actl.GetPattern())
+	gexp.ReplaceActual(actl.GetSubject())
+
+	reportBuilder.AddIssue(true, wrongRegexpMatchTemplate, actl.FuncName())
+
+	return true
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const reversedEqualTemplate = "the actual value (%s) is a constant, and the expected value is not; the failure message reads backwards; swap the actual and the expected values"
+
+// ReversedEqualRule finds Equal assertions of a constant actual value, with a non-constant expected
+// value; e.g. `Expect(42).To(Equal(x))`. The failure message is "Expected 42 to equal x", that
+// reads backwards. The rule suggests swapping the values: `Expect(x).To(Equal(42))`.
+//
+// The values are only swapped if they are of the same type, because Equal also compares the types.
+type ReversedEqualRule struct{}
+
+func (ReversedEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidReversedEqual && !gexp.IsAsync() && gexp.IsActualConstant() && gexp.MatcherTypeIs(matcher.EqualMatcherType) && !gexp.HasAliasedMatcher()
+}
+
+func (r ReversedEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || mtchr.GetValue() != nil || mtchr.GetType() == nil {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil || !gotypes.Identical(actualType, mtchr.GetType()) {
+		return false
+	}
+
+	actualExpr := gexp.GetActualArgExpr()
+	expectedExpr := gexp.GetMatcher().Clone.Args[0]
+
+	gexp.ReplaceActual(expectedExpr)
+	gexp.SetMatcherEqual(actualExpr)
+
+	reportBuilder.AddIssue(true, reversedEqualTemplate, reportBuilder.FormatExpr(gexp.GetOrigActualArgExpr()))
+
+	return true
+}
@@ -11,22 +11,80 @@ type Rule interface {
 }
 
 var rules = Rules{
+	&ExpectWithOffsetRule{},
+	&AssertionStyleRule{},
+	&ForceExpectRule{},
+	&NestedAssertionRule{},
 	&ForceExpectToRule{},
+	&ForceToNotRule{},
+	&TestingStateRule{},
+	&MethodExpressionRule{},
+	&BoolLiteralRule{},
+	&NegatedActualRule{},
+	&ConstantActualRule{},
+	&SwappedMatcherRule{},
+	&ReversedEqualRule{},
+	&ContextDoneRule{},
+	&TimeNowEqualRule{},
+	&ChannelStateRule{},
 	&LenRule{},
+	&RuneCountRule{},
 	&CapRule{},
+	&IncomparableRule{},
 	&ComparisonRule{},
+	&ElementOfRule{},
+	&DurationLiteralRule{},
+	&NegativeUnsignedRule{},
+	&FloatIntCompareRule{},
+	&NumericEqualRule{},
 	&NilCompareRule{},
 	&ComparePointRule{},
 	&ErrorEqualNilRule{},
+	&ErrorsIsRule{},
+	&ErrorsAsRule{},
+	&WrappedErrorEqualRule{},
+	&OsIsErrorRule{},
+	&StringsFuncRule{},
+	&RegexpMatchRule{},
+	&DeepEqualRule{},
+	&TimeCompareRule{},
+	&StrconvFormatRule{},
+	&ErrorStringRule{},
+	&MatchErrorStringRule{},
 	&MatchErrorRule{},
 	getMatcherOnlyRules(),
+	&SyncCopyRule{},
+	&MatchJSONRule{},
+	&RedundantConversionRule{},
+	&StringIndexRule{},
 	&EqualDifferentTypesRule{},
+	&EquivalentToRule{},
+	&ExactElementsRule{},
+	&BufferBytesRule{},
+	&StructPointerRule{},
+	&ProtoEqualRule{},
+	&LargeArrayEqualRule{},
+	&ErrorSliceEqualRule{},
+	&TimeStructEqualRule{},
+	&SQLNullRule{},
+	&ContainElementFieldRule{},
+	&TupleErrorRule{},
+	&SyncMapLoadRule{},
+	&FloatEqualRule{},
+	&WithTransformRule{},
 	&HaveOccurredRule{},
 	&SucceedRule{},
 }
 
 var asyncRules = Rules{
+	&ExpectWithOffsetRule{},
+	&NestedAssertionRule{},
+	&ForceToNotRule{},
 	&AsyncFuncCallRule{},
+	&AsyncBlockingRule{},
+	&ConsistentlyReceiveRule{},
+	&AsyncDiscardedErrorRule{},
+	&ContextDoneRule{},
 	&AsyncTimeIntervalsRule{},
 	&ErrorEqualNilRule{},
 	&MatchErrorRule{},
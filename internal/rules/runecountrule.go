@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const runeCountTemplate = "utf8.%s counts runes, and not bytes, so it is different than the length, for non-ASCII values; if the length in bytes is meant, use `%s` instead"
+
+// RuneCountRule finds equality assertions of the utf8.RuneCountInString or the utf8.RuneCount
+// functions, like `Expect(utf8.RuneCountInString(s)).To(Equal(5))`. Counting runes may be
+// intentional, but it is often confused with the length of the value in bytes, that is asserted
+// with the HaveLen matcher.
+//
+// This rule only suggests the replacement, but does not offer an auto fix.
+type RuneCountRule struct{}
+
+func (RuneCountRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidRuneCount && gexp.ActualArgTypeIs(actual.RuneCountArgType)
+}
+
+func (r RuneCountRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl, ok := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	if !ok || actl.NumArgs() != 1 {
+		return false
+	}
+
+	var expected ast.Expr
+	switch mtchr := gexp.GetMatcherInfo().(type) {
+	case *matcher.EqualMatcher:
+		expected = mtchr.GetValueExpr()
+	case *matcher.BeNumericallyMatcher:
+		if mtchr.GetOp() != token.EQL {
+			return false
+		}
+		expected = mtchr.GetValueExpr()
+	default:
+		return false
+	}
+
+	gexp.ReplaceActual(actl.GetArg(0))
+	gexp.SetMatcherLen(expected)
+
+	reportBuilder.AddIssue(false, runeCountTemplate, actl.FuncName(), reportBuilder.FormatExpr(gexp.GetClone()))
+
+	return true
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const sprintfNoVerbsTemplate = "redundant fmt.Sprintf in the %s matcher: the format string has no formatting verbs; use the string as is"
+
+// sprintfNoVerbsMatchers are the matchers this rule checks
+var sprintfNoVerbsMatchers = map[string]bool{
+	"ContainSubstring": true,
+	"Equal":            true,
+}
+
+// SprintfNoVerbsRule finds matchers with a fmt.Sprintf argument, that has no formatting verbs,
+// and suggests using the string literal instead; e.g.
+// `Expect(out).To(ContainSubstring(fmt.Sprintf("done")))` => `Expect(out).To(ContainSubstring("done"))`
+//
+// This rule is part of the comparison checks, and it is suppressed with them.
+type SprintfNoVerbsRule struct{}
+
+func (r SprintfNoVerbsRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if config.SuppressCompare {
+		return false
+	}
+
+	mtchr := gexp.GetMatcher()
+	matcherName := mtchr.GetMatcherInfo().MatcherName()
+	if !sprintfNoVerbsMatchers[matcherName] {
+		return false
+	}
+
+	format, ok := mtchr.GetVerbLessSprintfFormat()
+	if !ok {
+		return false
+	}
+
+	mtchr.Clone.Args[0] = format
+	reportBuilder.AddIssue(true, sprintfNoVerbsTemplate, matcherName)
+
+	return true
+}
@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+	"strings"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const sqlNullTemplate = "the expected %[1]s value sets %[2]s, but not Valid, so it is a NULL value; set `Valid: true`, or assert the %[2]s and the Valid fields separately"
+
+// SQLNullRule finds Equal assertions, with an expected database/sql Null* literal, like
+// sql.NullString, that sets the value field, but not the Valid field; e.g.
+// `Expect(name).To(Equal(sql.NullString{String: "x"}))`. The expected value is a NULL value, and
+// the assertion probably misses `Valid: true`.
+//
+// This rule is informational, and does not offer an auto fix.
+type SQLNullRule struct{}
+
+func (SQLNullRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidSQLNullNoValid && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r SQLNullRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || !isSQLNullType(mtchr.GetType()) {
+		return false
+	}
+
+	field, ok := r.getValueFieldWithoutValid(mtchr.GetValueExpr())
+	if !ok {
+		return false
+	}
+
+	typeName := gotypes.TypeString(mtchr.GetType(), func(pkg *gotypes.Package) string { return pkg.Name() })
+	reportBuilder.AddIssue(false, sqlNullTemplate, typeName, field)
+
+	// always return false, to keep checking another rules.
+	return false
+}
+
+// getValueFieldWithoutValid returns the name of the value field, if the expression is a keyed
+// literal that does not set the Valid field; e.g. `sql.NullString{String: "x"}`. An empty literal
+// is an intended NULL value, and an unkeyed literal always sets the Valid field.
+func (SQLNullRule) getValueFieldWithoutValid(expr ast.Expr) (string, bool) {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	if !ok || len(lit.Elts) == 0 {
+		return "", false
+	}
+
+	field := ""
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return "", false
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || key.Name == "Valid" {
+			return "", false
+		}
+
+		field = key.Name
+	}
+
+	return field, true
+}
+
+// isSQLNullType checks if the type is one of the database/sql Null* types, like sql.NullString,
+// or the generic sql.Null[T] type
+func isSQLNullType(t gotypes.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	named, ok := t.(*gotypes.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "database/sql" && strings.HasPrefix(obj.Name(), "Null")
+}
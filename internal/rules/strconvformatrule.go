@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"strconv"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const strconvFormatTemplate = "asserting the string conversion of %[1]s, by strconv.%[2]s; assert the numeric value itself, instead; e.g. `Expect(%[1]s).%[3]s(%[4]s)`"
+
+// StrconvFormatRule finds Equal assertions of the result of strconv.Itoa or strconv.FormatInt,
+// with a string literal, and suggests asserting the numeric value itself; e.g. replace
+// `Expect(strconv.Itoa(n)).To(Equal("5"))` with `Expect(n).To(Equal(5))`.
+//
+// strconv.FormatInt receives an int64, so the suggestion uses `BeNumerically("==", 5)`, that does
+// not depend on the type of its argument. The rule only triggers if the expected string is a number
+// in the base of the conversion, as strconv formats it; e.g. not "05".
+//
+// This rule is informational, and does not offer an auto fix.
+type StrconvFormatRule struct{}
+
+func (StrconvFormatRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidStrconvFormatEqual &&
+		gexp.ActualArgTypeIs(actual.StrconvFormatArgType) &&
+		gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r StrconvFormatRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok {
+		return false
+	}
+
+	expected := mtchr.GetValue()
+	if expected == nil || expected.Kind() != constant.String {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	base, ok := r.getBase(actl)
+	if !ok {
+		return false
+	}
+
+	str := constant.StringVal(expected)
+	num, err := strconv.ParseInt(str, base, 64)
+	if err != nil || strconv.FormatInt(num, base) != str {
+		return false
+	}
+
+	suggestedMatcher := "Equal(" + strconv.FormatInt(num, 10) + ")"
+	if actl.FuncName() == "FormatInt" {
+		suggestedMatcher = `BeNumerically("==", ` + strconv.FormatInt(num, 10) + ")"
+	}
+
+	assertionName := "To"
+	if gexp.IsNegativeAssertion() {
+		assertionName = "ToNot"
+	}
+
+	reportBuilder.AddIssue(false, strconvFormatTemplate, reportBuilder.FormatExpr(actl.GetOrigArg(0)), actl.FuncName(), assertionName, suggestedMatcher)
+
+	// always return false, to keep checking another rules.
+	return false
+}
+
+// getBase returns the base of the conversion: 10 for strconv.Itoa, or the base argument of
+// strconv.FormatInt, if it is an integer literal
+func (StrconvFormatRule) getBase(actl *actual.PkgFuncCallPayload) (int, bool) {
+	if actl.FuncName() == "Itoa" {
+		return 10, actl.NumArgs() == 1
+	}
+
+	if actl.NumArgs() != 2 {
+		return 0, false
+	}
+
+	lit, ok := ast.Unparen(actl.GetOrigArg(1)).(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, false
+	}
+
+	base, err := strconv.Atoi(lit.Value)
+	if err != nil || base < 2 || base > 36 {
+		return 0, false
+	}
+
+	return base, true
+}
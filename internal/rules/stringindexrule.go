@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	gotypes "go/types"
+	"strconv"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const (
+	stringIndexTemplate      = "comparing a string index with a string always fails, because the index of a string is a byte"
+	stringIndexNoFixTemplate = stringIndexTemplate + "; compare it with a byte, or use the ContainSubstring or the HavePrefix matchers with the string"
+)
+
+// StringIndexRule finds Equal assertions of a string index, that is a byte, with a string value;
+// e.g. `Expect(s[0]).To(Equal("a"))`. Such an assertion always fails.
+//
+// If the expected value is a single character string literal, the rule suggests comparing with a
+// byte instead; e.g. `Expect(s[0]).To(Equal(byte('a')))`.
+//
+// This rule is part of the type comparison checks, and it is suppressed with them.
+type StringIndexRule struct{}
+
+func (StringIndexRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressTypeCompare &&
+		gexp.ActualArgTypeIs(actual.StringIndexArgType) &&
+		gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r StringIndexRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(*matcher.EqualMatcher)
+	if !ok || mtchr.GetType() == nil {
+		return false
+	}
+
+	if basic, ok := mtchr.GetType().Underlying().(*gotypes.Basic); !ok || basic.Info()&gotypes.IsString == 0 {
+		return false
+	}
+
+	if b, ok := r.getSingleByte(mtchr.GetValue()); ok {
+		gexp.ReplaceMatcherArgs([]ast.Expr{&ast.CallExpr{
+			Fun:  ast.NewIdent("byte"),
+			Args: []ast.Expr{&ast.BasicLit{Kind: token.CHAR, Value: strconv.QuoteRune(rune(b))}},
+		}})
+		reportBuilder.AddIssue(true, stringIndexTemplate)
+
+		return true
+	}
+
+	reportBuilder.AddIssue(false, stringIndexNoFixTemplate)
+
+	return true
+}
+
+// getSingleByte returns the byte of a constant string of a single ASCII character
+func (StringIndexRule) getSingleByte(val constant.Value) (byte, bool) {
+	if val == nil || val.Kind() != constant.String {
+		return 0, false
+	}
+
+	s := constant.StringVal(val)
+	if len(s) != 1 || s[0] >= 0x80 {
+		return 0, false
+	}
+
+	return s[0], true
+}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const wrongStringsFuncTemplate = "wrong strings.%s assertion; use the %s matcher instead, for a better failure message"
+
+// stringsFuncMatchers maps the strings functions to the gomega matchers that replace them
+var stringsFuncMatchers = map[string]string{
+	"Contains":  "ContainSubstring",
+	"HasPrefix": "HavePrefix",
+	"HasSuffix": "HaveSuffix",
+}
+
+// StringsFuncRule finds boolean assertions of the strings.Contains, strings.HasPrefix and
+// strings.HasSuffix functions, and suggests using the ContainSubstring, the HavePrefix and the
+// HaveSuffix matchers instead; e.g. replace `Expect(strings.HasPrefix(s, "foo")).To(BeTrue())` with
+// `Expect(s).To(HavePrefix("foo"))`
+//
+// This rule is part of the comparison checks, and it is suppressed with them.
+type StringsFuncRule struct{}
+
+func (StringsFuncRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressCompare &&
+		gexp.ActualArgTypeIs(actual.StringsFuncArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r StringsFuncRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.PkgFuncCallPayload)
+	matcherName, ok := stringsFuncMatchers[actl.FuncName()]
+	if !ok || actl.NumArgs() != 2 {
+		return false
+	}
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherStringsFunc(matcherName, actl.GetArg(1))
+	gexp.ReplaceActual(actl.GetArg(0))
+
+	reportBuilder.AddIssue(true, wrongStringsFuncTemplate, actl.FuncName(), matcherName)
+
+	return true
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const structPointerTemplate = "comparing a struct with a pointer field (%s), using Equal; Equal compares the pointed values, and not the pointers themselves, so different pointers to equal values are considered as equal"
+
+// StructPointerRule finds Equal assertions of a struct, that has a pointer field, directly or in a
+// nested struct field; e.g. `Expect(s).To(Equal(other))`, when s has a `*int` field. Gomega uses
+// reflect.DeepEqual, that compares the pointed values, and not the pointers, and it may surprise
+// when the test expects a shared pointer.
+//
+// This rule is informational, and does not offer an auto fix.
+type StructPointerRule struct{}
+
+func (StructPointerRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidStructPointerEqual && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r StructPointerRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	field, ok := r.getPointerField(actualType, map[gotypes.Type]bool{})
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, structPointerTemplate, field)
+
+	// always return false, to keep checking another rules.
+	return false
+}
+
+// getPointerField returns the name of the first pointer field of the struct, including the fields
+// of nested structs, like `Inner.P`
+func (r StructPointerRule) getPointerField(t gotypes.Type, visited map[gotypes.Type]bool) (string, bool) {
+	if visited[t] {
+		return "", false
+	}
+	visited[t] = true
+
+	st, ok := t.Underlying().(*gotypes.Struct)
+	if !ok {
+		return "", false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if _, ok := field.Type().Underlying().(*gotypes.Pointer); ok {
+			return field.Name(), true
+		}
+
+		if name, ok := r.getPointerField(field.Type(), visited); ok {
+			return field.Name() + "." + name, true
+		}
+	}
+
+	return "", false
+}
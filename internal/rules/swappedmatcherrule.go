@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"go/ast"
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/interfaces"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const swappedMatcherTemplate = "the actual value and the matcher are swapped; %s is a gomega matcher, and should be passed to the assertion method"
+
+// SwappedMatcherRule finds assertions of a gomega matcher, with the Equal, BeEquivalentTo or
+// BeIdenticalTo matcher of a value that is not a matcher; e.g. `Expect(HaveLen(3)).To(Equal(s))`,
+// that compares the HaveLen matcher itself with s. The rule suggests swapping them; e.g.
+// `Expect(s).To(HaveLen(3))`.
+//
+// If the actual value is not a function call, like a variable, the rule does not offer an auto fix.
+//
+// The rule is part of the type comparison checks, and so it is suppressed by the
+// --suppress-type-compare-assertion flag, or by the ginkgo-linter:ignore-type-compare-warning comment.
+type SwappedMatcherRule struct{}
+
+func (SwappedMatcherRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressTypeCompare &&
+		!gexp.IsAsync() &&
+		!gexp.HasAliasedMatcher() &&
+		gexp.MatcherTypeIs(matcher.EqualMatcherType|matcher.BeEquivalentToMatcherType|matcher.BeIdenticalToMatcherType) &&
+		interfaces.ImplementsGomegaMatcher(gexp.GetActualArgGOType())
+}
+
+func (r SwappedMatcherRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	mtchr, ok := gexp.GetMatcherInfo().(interface {
+		GetType() gotypes.Type
+		GetValueExpr() ast.Expr
+	})
+	if !ok || interfaces.ImplementsGomegaMatcher(mtchr.GetType()) {
+		return false
+	}
+
+	actualMatcher, ok := ast.Unparen(gexp.GetActualArgExpr()).(*ast.CallExpr)
+	if !ok {
+		reportBuilder.AddIssue(false, swappedMatcherTemplate, reportBuilder.FormatExpr(gexp.GetOrigActualArgExpr()))
+		return true
+	}
+
+	expected := mtchr.GetValueExpr()
+	gexp.ReplaceMatcher(actualMatcher)
+	gexp.ReplaceActual(expected)
+
+	reportBuilder.AddIssue(true, swappedMatcherTemplate, reportBuilder.FormatExpr(gexp.GetOrigActualArgExpr()))
+
+	return true
+}
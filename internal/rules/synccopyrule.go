@@ -0,0 +1,81 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const syncCopyTemplate = "use %s with a value that contains a %s; the comparison copies the %[2]s, and compares its internal state. Assert on the data it guards instead"
+
+// noCopySyncTypes are the types from the sync package, that must not be copied after first use
+var noCopySyncTypes = map[string]bool{
+	"WaitGroup": true,
+	"Mutex":     true,
+	"RWMutex":   true,
+	"Once":      true,
+}
+
+// SyncCopyRule finds Equal, BeEquivalentTo and BeIdenticalTo assertions of values that contain a
+// sync type that must not be copied, like sync.WaitGroup or sync.Mutex; e.g.
+// `Expect(wg).To(Equal(sync.WaitGroup{}))`.
+//
+// Both the actual value and the expected value are copied when they are passed to gomega, and the
+// comparison uses the internal state of the lock, that is meaningless for the test.
+type SyncCopyRule struct{}
+
+func (r SyncCopyRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	var matcherType gotypes.Type
+	switch mtchr := gexp.GetMatcherInfo().(type) {
+	case *matcher.EqualMatcher:
+		matcherType = mtchr.GetType()
+	case *matcher.BeEquivalentToMatcher:
+		matcherType = mtchr.GetType()
+	case *matcher.BeIdenticalToMatcher:
+		matcherType = mtchr.GetType()
+	default:
+		return false
+	}
+
+	for _, t := range []gotypes.Type{gexp.GetActualArgGOType(), matcherType} {
+		if name, found := findNoCopySyncType(t, map[gotypes.Type]bool{}); found {
+			reportBuilder.AddIssue(false, syncCopyTemplate, gexp.GetMatcherInfo().MatcherName(), name)
+			return true
+		}
+	}
+
+	return false
+}
+
+// findNoCopySyncType looks for a no-copy sync type, that is part of the value of t; i.e. t itself,
+// or a struct field or an array element, but not behind a pointer, a slice or a map.
+func findNoCopySyncType(t gotypes.Type, seen map[gotypes.Type]bool) (string, bool) {
+	if t == nil || seen[t] {
+		return "", false
+	}
+	seen[t] = true
+
+	if named, ok := t.(*gotypes.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "sync" && noCopySyncTypes[obj.Name()] {
+			return "sync." + obj.Name(), true
+		}
+	}
+
+	switch typ := t.Underlying().(type) {
+	case *gotypes.Struct:
+		for i := range typ.NumFields() {
+			if name, found := findNoCopySyncType(typ.Field(i).Type(), seen); found {
+				return name, true
+			}
+		}
+
+	case *gotypes.Array:
+		return findNoCopySyncType(typ.Elem(), seen)
+	}
+
+	return "", false
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const syncMapLoadTemplate = "sync.Map.%s returns a value and a boolean; gomega requires the extra values to be zero, so this assertion fails whenever the key is found; assign the results to variables, and assert them separately"
+
+// SyncMapLoadRule finds synchronous assertions of the two results of a sync.Map method, like
+// `Expect(m.Load(key)).To(Equal(value))`. Gomega requires all the extra values to be nil or zero,
+// so the assertion fails when the boolean result is true. The results should be asserted
+// separately:
+//
+//	v, ok := m.Load(key)
+//	Expect(ok).To(BeTrue())
+//	Expect(v).To(Equal(value))
+//
+// This rule does not offer an auto fix.
+type SyncMapLoadRule struct{}
+
+func (SyncMapLoadRule) isApplied(gexp *expression.GomegaExpression) bool {
+	return !gexp.IsAsync() && gexp.IsActualTuple()
+}
+
+func (r SyncMapLoadRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp) {
+		return false
+	}
+
+	method, ok := gexp.GetActualSyncMapLoadMethod()
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, syncMapLoadTemplate, method)
+
+	// always return false, to keep checking another rules.
+	return false
+}
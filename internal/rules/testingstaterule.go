@@ -0,0 +1,35 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const testingStateTemplate = "asserting the test state with %s() mixes the testing framework with gomega; gomega already fails the test when an assertion fails, so there is no need to assert the test state"
+
+// TestingStateRule finds assertions of the testing framework state, like
+// `Expect(t.Failed()).To(BeFalse())`, when t is a *testing.T, a testing.TB or the ginkgo T
+// interface (e.g. `GinkgoT()`).
+//
+// This rule does not offer an auto fix.
+type TestingStateRule struct{}
+
+func (TestingStateRule) isApplied(config types.Config) bool {
+	return config.ForbidTestingStateAssertion
+}
+
+func (r TestingStateRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(config) {
+		return false
+	}
+
+	method, ok := gexp.GetTestingStateMethod()
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, testingStateTemplate, method)
+
+	return true
+}
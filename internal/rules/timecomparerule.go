@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const wrongTimeCompareTemplate = "wrong time.Time.%s assertion; use the BeTemporally matcher instead, for a better failure message"
+
+// TimeCompareRule finds boolean assertions of the After, the Before and the Equal methods of a
+// time.Time value, and suggests using the BeTemporally matcher instead; e.g. replace
+// `Expect(t1.After(t2)).To(BeTrue())` with `Expect(t1).To(BeTemporally(">", t2))`
+//
+// This rule is part of the comparison checks, and it is suppressed with them.
+type TimeCompareRule struct{}
+
+func (TimeCompareRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressCompare &&
+		gexp.ActualArgTypeIs(actual.TimeCompareArgType) &&
+		gexp.MatcherTypeIs(matcher.BoolValueTrue|matcher.BoolValueFalse)
+}
+
+func (r TimeCompareRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actl := gexp.GetActualArg().(*actual.TimeComparePayload)
+
+	if gexp.MatcherTypeIs(matcher.BoolValueFalse) {
+		gexp.ReverseAssertionFuncLogic()
+	}
+
+	gexp.SetMatcherBeTemporally(actl.GetOp(), actl.GetOther())
+	gexp.ReplaceActual(actl.GetReceiver())
+
+	reportBuilder.AddIssue(true, wrongTimeCompareTemplate, actl.MethodName())
+
+	return true
+}
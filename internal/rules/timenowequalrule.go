@@ -0,0 +1,37 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const timeNowEqualTemplate = "comparing time.Now() using %s; the current time is never equal to a time value that was taken before, so this assertion always %s; use the BeTemporally matcher instead; e.g. `BeTemporally(\"~\", expected, time.Second)`"
+
+// TimeNowEqualRule finds Equal and BeIdenticalTo assertions of time.Now(); e.g.
+// `Expect(time.Now()).To(Equal(expected))`. The current time, that also includes the monotonic
+// clock reading, is never equal to another time value, so the assertion always fails (or always
+// passes, for a negative assertion).
+//
+// The rule does not offer an auto fix, because the right tolerance is not known.
+type TimeNowEqualRule struct{}
+
+func (TimeNowEqualRule) isApplied(gexp *expression.GomegaExpression) bool {
+	return gexp.IsActualTimeNow() && gexp.MatcherTypeIs(matcher.EqualMatcherType|matcher.BeIdenticalToMatcherType)
+}
+
+func (r TimeNowEqualRule) Apply(gexp *expression.GomegaExpression, _ types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp) {
+		return false
+	}
+
+	result := "fails"
+	if gexp.IsNegativeAssertion() {
+		result = "passes"
+	}
+
+	reportBuilder.AddIssue(false, timeNowEqualTemplate, gexp.GetMatcherInfo().MatcherName(), result)
+
+	return true
+}
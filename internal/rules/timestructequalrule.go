@@ -0,0 +1,91 @@
+package rules
+
+import (
+	gotypes "go/types"
+
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const timeStructEqualTemplate = "comparing a struct with a time.Time field (%s) using Equal; two time values of the same instant may have a different location or monotonic clock reading, so this assertion may fail; consider using BeComparableTo, that compares time values with their Equal method, or the gstruct matchers"
+
+// TimeStructEqualRule finds Equal assertions of a struct, or a pointer to a struct, with a
+// time.Time field; e.g. `Expect(got).To(Equal(want))`. Equal uses reflect.DeepEqual, that also
+// compares the location and the monotonic clock reading of the time values.
+//
+// This rule is informational, and does not offer an auto fix.
+type TimeStructEqualRule struct{}
+
+func (TimeStructEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForbidTimeStructEqual && gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r TimeStructEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	actualType := gexp.GetActualArgGOType()
+	if actualType == nil {
+		return false
+	}
+
+	if ptr, ok := actualType.Underlying().(*gotypes.Pointer); ok {
+		actualType = ptr.Elem()
+	}
+
+	if isTimeType(actualType) {
+		return false
+	}
+
+	field, ok := findTimeField(actualType, map[gotypes.Type]bool{})
+	if !ok {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, timeStructEqualTemplate, field)
+
+	// always return false, to keep checking another rules.
+	return false
+}
+
+// findTimeField returns the path of the first time.Time field of a struct type, including the
+// fields of embedded and nested structs; e.g. "Meta.CreatedAt"
+func findTimeField(t gotypes.Type, visited map[gotypes.Type]bool) (string, bool) {
+	st, ok := t.Underlying().(*gotypes.Struct)
+	if !ok || visited[t] {
+		return "", false
+	}
+	visited[t] = true
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		fieldType := field.Type()
+		if ptr, ok := fieldType.(*gotypes.Pointer); ok {
+			fieldType = ptr.Elem()
+		}
+
+		if isTimeType(fieldType) {
+			return field.Name(), true
+		}
+
+		if nested, ok := findTimeField(fieldType, visited); ok {
+			return field.Name() + "." + nested, true
+		}
+	}
+
+	return "", false
+}
+
+// isTimeType checks if the type is the time.Time type, by its package path and name
+func isTimeType(t gotypes.Type) bool {
+	named, ok := t.(*gotypes.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time"
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const tupleErrorTemplate = "%s returns a value and an error, and the error is only implicitly asserted to be nil; assign the results to variables, and assert the error explicitly; e.g. `Expect(err).ToNot(HaveOccurred())`"
+
+// TupleErrorRule finds synchronous assertions of a function call with multiple results, that its
+// last result is an error, with a value matcher; e.g. `Expect(strconv.Atoi(s)).To(Equal(5))`.
+// Gomega requires the extra values to be nil or zero, so the error is checked, but not explicitly,
+// and the failure message does not tell it is an error check. The rule suggests binding the
+// results, and asserting the error explicitly:
+//
+//	n, err := strconv.Atoi(s)
+//	Expect(err).ToNot(HaveOccurred())
+//	Expect(n).To(Equal(5))
+//
+// The Succeed and the HaveOccurred matchers are handled by their own rules.
+//
+// This rule does not offer an auto fix.
+type TupleErrorRule struct{}
+
+func (TupleErrorRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	if !config.ForbidImplicitTupleError || gexp.IsAsync() || !gexp.IsActualTupleWithError() {
+		return false
+	}
+
+	return !gexp.MatcherTypeIs(matcher.SucceedMatcherType | matcher.HaveOccurredMatcherType)
+}
+
+func (r TupleErrorRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	reportBuilder.AddIssue(false, tupleErrorTemplate, reportBuilder.FormatExpr(gexp.GetOrigActualArgExpr()))
+
+	// always return false, to keep checking another rules.
+	return false
+}
@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const withTransformTemplate = "the actual value is transformed by %s before the assertion, so the original value is missing from the failure message; use the WithTransform matcher instead"
+
+// WithTransformRule finds assertions of the result of a package level function, that transforms a
+// single value, and suggests using the WithTransform matcher, so the failure message shows the
+// original value; e.g. replace `Expect(strings.ToLower(s)).To(Equal("abc"))` with
+// `Expect(s).To(WithTransform(strings.ToLower, Equal("abc")))`
+type WithTransformRule struct{}
+
+func (WithTransformRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return config.ForceWithTransform &&
+		!gexp.IsAsync() &&
+		gexp.ActualArgTypeIs(actual.UnknownActualArgType)
+}
+
+func (r WithTransformRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	call, ok := gexp.GetActualTransformCall()
+	if !ok {
+		return false
+	}
+
+	gexp.ReplaceActual(call.Args[0])
+	gexp.WrapMatcherWithTransform(call.Fun)
+
+	reportBuilder.AddIssue(true, withTransformTemplate, reportBuilder.FormatExpr(call.Fun))
+
+	return true
+}
@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"github.com/nunnatsa/ginkgolinter/internal/expression"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/actual"
+	"github.com/nunnatsa/ginkgolinter/internal/expression/matcher"
+	"github.com/nunnatsa/ginkgolinter/internal/reports"
+	"github.com/nunnatsa/ginkgolinter/types"
+)
+
+const wrappedErrorEqualTemplate = "comparing an error with a new wrapped error is fragile, because Equal compares the internal structure of the errors; use MatchError with the wrapped error instead"
+
+// WrappedErrorEqualRule finds Equal assertions of an error, with a new error that is created by a
+// fmt.Errorf call with a %w verb, and suggests using the MatchError matcher with the wrapped error
+// instead; e.g. replace `Expect(err).To(Equal(fmt.Errorf("wrap: %w", inner)))` with
+// `Expect(err).To(MatchError(inner))`
+type WrappedErrorEqualRule struct{}
+
+func (WrappedErrorEqualRule) isApplied(gexp *expression.GomegaExpression, config types.Config) bool {
+	return !config.SuppressErr &&
+		gexp.ActualArgTypeIs(actual.ErrorTypeArgType) &&
+		gexp.MatcherTypeIs(matcher.EqualMatcherType)
+}
+
+func (r WrappedErrorEqualRule) Apply(gexp *expression.GomegaExpression, config types.Config, reportBuilder *reports.Builder) bool {
+	if !r.isApplied(gexp, config) {
+		return false
+	}
+
+	wrapped, ok := gexp.GetMatcher().GetWrappedError()
+	if !ok {
+		return false
+	}
+
+	gexp.SetMatcherMatchError(wrapped)
+	reportBuilder.AddIssue(true, wrappedErrorEqualTemplate)
+
+	return true
+}
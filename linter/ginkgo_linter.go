@@ -5,6 +5,7 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 
+	"github.com/nunnatsa/ginkgolinter/internal/blockrules"
 	"github.com/nunnatsa/ginkgolinter/internal/expression"
 	"github.com/nunnatsa/ginkgolinter/internal/formatter"
 	"github.com/nunnatsa/ginkgolinter/internal/ginkgohandler"
@@ -45,6 +46,11 @@ func (l *GinkgoLinter) Run(pass *analysis.Pass) (any, error) {
 			continue
 		}
 
+		var blockCtx *blockrules.Context
+		if gomegaHndlr != nil {
+			blockCtx = blockrules.NewContext(pass, gomegaHndlr, fileConfig, cm, getTimePkg(file))
+		}
+
 		ast.Inspect(file, func(n ast.Node) bool {
 			if ginkgoHndlr != nil {
 				goDeeper := false
@@ -59,6 +65,17 @@ func (l *GinkgoLinter) Run(pass *analysis.Pass) (any, error) {
 				}
 			}
 
+			if ginkgoHndlr != nil {
+				switch loop := n.(type) {
+				case *ast.RangeStmt, *ast.ForStmt:
+					ginkgoHndlr.HandleGinkgoLoop(loop.(ast.Stmt), fileConfig, pass)
+				}
+			}
+
+			if blockCtx != nil {
+				blockrules.Apply(n, blockCtx)
+			}
+
 			stmt, ok := n.(*ast.ExprStmt)
 			if !ok {
 				return true
@@ -86,7 +103,7 @@ func (l *GinkgoLinter) Run(pass *analysis.Pass) (any, error) {
 				return true
 			}
 
-			gexp, ok := expression.New(assertionExp, pass, gomegaHndlr, getTimePkg(file))
+			gexp, ok := expression.New(assertionExp, pass, gomegaHndlr, getTimePkg(file), config.MatcherAliases)
 			if !ok || gexp == nil {
 				return true
 			}
@@ -112,7 +129,9 @@ func checkGomegaExpression(gexp *expression.GomegaExpression, config types.Confi
 	}
 
 	if reportBuilder.HasReport() {
-		reportBuilder.SetFixOffer(gexp.GetClone())
+		if !gexp.HasAliasedMatcher() {
+			reportBuilder.SetFixOffer(gexp.GetClone())
+		}
 		pass.Report(reportBuilder.Build())
 	}
 
@@ -0,0 +1,44 @@
+package assertionresult
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("assertion result", func() {
+	It("should trigger a warning", func() {
+		x := 5
+
+		ok := Expect(x).To(Equal(5)) // want `ginkgo-linter: the result of the To assertion is not needed; gomega already fails the test if the assertion fails; use the assertion as a statement instead`
+		GinkgoWriter.Println(ok)
+
+		var ok2 = Expect(x).ShouldNot(BeZero()) // want `ginkgo-linter: the result of the ShouldNot assertion is not needed; gomega already fails the test if the assertion fails; use the assertion as a statement instead`
+		GinkgoWriter.Println(ok2)
+
+		_ = Ω(x).Should(Equal(5)) // want `ginkgo-linter: the result of the Should assertion is not needed; gomega already fails the test if the assertion fails; use the assertion as a statement instead`
+
+		ok = Eventually(func() int { return x }).Should(Equal(5)) // want `ginkgo-linter: the result of the Should assertion is not needed; gomega already fails the test if the assertion fails; use the assertion as a statement instead`
+		GinkgoWriter.Println(ok)
+
+		if Expect(x).To(Equal(5)) { // want `ginkgo-linter: the result of the To assertion is not needed; gomega already fails the test if the assertion fails; use the assertion as a statement instead`
+			GinkgoWriter.Println("ok")
+		}
+	})
+
+	It("should not trigger a warning", func() {
+		x := 5
+		Expect(x).To(Equal(5))
+		Eventually(func() int { return x }).Should(Equal(5))
+
+		ok := x == 5
+		if ok {
+			GinkgoWriter.Println("ok")
+		}
+
+		assertion := Expect(x)
+		assertion.To(Equal(5))
+
+		g := NewWithT(GinkgoT())
+		g.Expect(x).To(Equal(5))
+	})
+})
@@ -0,0 +1,29 @@
+package assertionstyleexpect
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("expect assertion style", func() {
+	x := 5
+
+	It("should trigger a warning", func() {
+		Ω(x).Should(Equal(5))                   // want `ginkgo-linter: wrong assertion style; use Expect\(\.\.\.\)\.To\(\.\.\.\), according to the gomega-expect assertion style\. Consider using .Expect\(x\)\.To\(Equal\(5\)\). instead`
+		Ω(x).ShouldNot(Equal(4))                // want `ginkgo-linter: wrong assertion style; use Expect\(\.\.\.\)\.ToNot\(\.\.\.\), according to the gomega-expect assertion style\. Consider using .Expect\(x\)\.ToNot\(Equal\(4\)\). instead`
+		Expect(x).Should(Equal(5))              // want `ginkgo-linter: wrong assertion style; use Expect\(\.\.\.\)\.To\(\.\.\.\), according to the gomega-expect assertion style\. Consider using .Expect\(x\)\.To\(Equal\(5\)\). instead`
+		ExpectWithOffset(1, x).Should(Equal(5)) // want `ginkgo-linter: wrong assertion style; use ExpectWithOffset\(\.\.\.\)\.To\(\.\.\.\), according to the gomega-expect assertion style\. Consider using .ExpectWithOffset\(1, x\)\.To\(Equal\(5\)\). instead`
+	})
+
+	It("should trigger a warning with a gomega variable", func() {
+		g := NewWithT(GinkgoT())
+		g.Ω(x).Should(Equal(5)) // want `ginkgo-linter: wrong assertion style; use Expect\(\.\.\.\)\.To\(\.\.\.\), according to the gomega-expect assertion style\. Consider using .g\.Expect\(x\)\.To\(Equal\(5\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(x).To(Equal(5))
+		Expect(x).ToNot(Equal(4))
+		Expect(x).NotTo(Equal(4))
+		Eventually(func() int { return x }).Should(Equal(5))
+	})
+})
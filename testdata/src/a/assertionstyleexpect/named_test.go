@@ -0,0 +1,12 @@
+package assertionstyleexpect
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("expect assertion style, with named import", func() {
+	It("should trigger a warning", func() {
+		gomega.Ω(5).Should(gomega.Equal(5)) // want `ginkgo-linter: wrong assertion style; use Expect\(\.\.\.\)\.To\(\.\.\.\), according to the gomega-expect assertion style\. Consider using .gomega\.Expect\(5\)\.To\(gomega\.Equal\(5\)\). instead`
+	})
+})
@@ -0,0 +1,25 @@
+package assertionstyleomega
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("omega assertion style", func() {
+	x := 5
+
+	It("should trigger a warning", func() {
+		Expect(x).To(Equal(5))     // want `ginkgo-linter: wrong assertion style; use Ω\(\.\.\.\)\.Should\(\.\.\.\), according to the gomega-omega assertion style\. Consider using .Ω\(x\)\.Should\(Equal\(5\)\). instead`
+		Expect(x).ToNot(Equal(4))  // want `ginkgo-linter: wrong assertion style; use Ω\(\.\.\.\)\.ShouldNot\(\.\.\.\), according to the gomega-omega assertion style\. Consider using .Ω\(x\)\.ShouldNot\(Equal\(4\)\). instead`
+		Expect(x).NotTo(Equal(4))  // want `ginkgo-linter: wrong assertion style; use Ω\(\.\.\.\)\.ShouldNot\(\.\.\.\), according to the gomega-omega assertion style\. Consider using .Ω\(x\)\.ShouldNot\(Equal\(4\)\). instead`
+		Expect(x).Should(Equal(5)) // want `ginkgo-linter: wrong assertion style; use Ω\(\.\.\.\)\.Should\(\.\.\.\), according to the gomega-omega assertion style\. Consider using .Ω\(x\)\.Should\(Equal\(5\)\). instead`
+		Ω(x).To(Equal(5))          // want `ginkgo-linter: wrong assertion style; use Ω\(\.\.\.\)\.Should\(\.\.\.\), according to the gomega-omega assertion style\. Consider using .Ω\(x\)\.Should\(Equal\(5\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Ω(x).Should(Equal(5))
+		Ω(x).ShouldNot(Equal(4))
+		ExpectWithOffset(1, x).To(Equal(5))
+		Eventually(func() int { return x }).Should(Equal(5))
+	})
+})
@@ -0,0 +1,39 @@
+package asyncblocking
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("blocking functions in async assertions", func() {
+	It("should trigger a warning", func() {
+		ch := make(chan int, 1)
+		ch <- 1
+		Consistently(func() int { return <-ch }).Should(Equal(1)) // want `ginkgo-linter: the function of Consistently only runs a channel receive, that blocks the polling; gomega can't check the timeout or the polling interval while the function is blocked; use a channel directly as the actual value, or a non-blocking function`
+
+		var wg sync.WaitGroup
+		Eventually(func() { wg.Wait() }).ShouldNot(Panic()) // want `ginkgo-linter: the function of Eventually only runs sync\.WaitGroup\.Wait\(\), that blocks the polling`
+
+		Consistently(func() { time.Sleep(time.Second) }).ShouldNot(Panic()) // want `ginkgo-linter: the function of Consistently only runs time\.Sleep\(\), that blocks the polling`
+	})
+
+	It("should not trigger a warning", func() {
+		ch := make(chan int, 1)
+		ch <- 1
+		Eventually(ch).Should(Receive())
+
+		Consistently(func() int { return len(ch) }).Should(Equal(1))
+
+		Eventually(func() int {
+			select {
+			case v := <-ch:
+				return v
+			default:
+				return 0
+			}
+		}).Should(Equal(1))
+	})
+})
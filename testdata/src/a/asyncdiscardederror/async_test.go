@@ -0,0 +1,66 @@
+package asyncdiscardederror
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getValue() (int, error) {
+	return 1, nil
+}
+
+func check() error {
+	return errors.New("fake error")
+}
+
+var _ = Describe("discarded errors in async functions", func() {
+	It("should trigger a warning", func() {
+		Eventually(func() int { // want "ginkgo-linter: the function of Eventually discards the error of getValue\\(\\); an error in the polled function is silently ignored; return the error and use the Succeed matcher, or get a Gomega argument and assert it with `g\\.Expect\\(err\\)\\.ToNot\\(HaveOccurred\\(\\)\\)`"
+			v, _ := getValue()
+			return v
+		}).Should(Equal(1))
+
+		Consistently(func() bool { // want `ginkgo-linter: the function of Consistently discards the error of check\(\); an error in the polled function is silently ignored`
+			_ = check()
+			return true
+		}).Should(BeTrue())
+
+		var v int
+		Eventually(func() int { // want `ginkgo-linter: the function of Eventually discards the error of getValue\(\)`
+			if true {
+				v, _ = getValue()
+			}
+			return v
+		}).Should(Equal(1))
+	})
+
+	It("should not trigger a warning", func() {
+		Eventually(func(g Gomega) int {
+			v, err := getValue()
+			g.Expect(err).ToNot(HaveOccurred())
+			return v
+		}).Should(Equal(1))
+
+		Eventually(func() (int, error) {
+			return getValue()
+		}).Should(Equal(1))
+
+		Eventually(func() int {
+			m := map[string]int{"a": 1}
+			v, _ := m["a"]
+			return v
+		}).Should(Equal(1))
+
+		Eventually(func() int {
+			go func() {
+				_, _ = getValue()
+			}()
+			return 1
+		}).Should(Equal(1))
+
+		v, _ := getValue()
+		Expect(v).To(Equal(1))
+	})
+})
@@ -0,0 +1,58 @@
+package asyncgomegaparam
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("async function that runs assertions without a Gomega argument", func() {
+	It("should trigger a warning", func() {
+		x := 5
+		Eventually(func() bool {
+			Expect(x).To(Equal(5)) // want "ginkgo-linter: the function of Eventually runs a gomega assertion, but it does not receive a Gomega argument; a failure of this assertion fails the test immediately, instead of polling again; add a `g Gomega` parameter to the function, and use `g\\.Expect`"
+			return true
+		}).Should(BeTrue())
+
+		Consistently(func() int {
+			Ω(x).ShouldNot(BeZero()) // want `ginkgo-linter: the function of Consistently runs a gomega assertion, but it does not receive a Gomega argument`
+			return x
+		}).WithTimeout(time.Millisecond).Should(Equal(5))
+
+		g := NewWithT(GinkgoT())
+		Eventually(func() error {
+			if x > 0 {
+				g.Expect(x).To(BeNumerically(">", 0)) // want `ginkgo-linter: the function of Eventually runs a gomega assertion, but it does not receive a Gomega argument`
+			}
+			return nil
+		}).Should(Succeed())
+	})
+
+	It("should not trigger a warning", func() {
+		x := 5
+		Eventually(func(g Gomega) {
+			g.Expect(x).To(Equal(5))
+		}).Should(Succeed())
+
+		Eventually(func() error {
+			return nil
+		}).Should(Succeed())
+
+		Eventually(func() int {
+			defer func() {
+				Expect(x).To(Equal(5))
+			}()
+			return x
+		}).Should(Equal(5))
+	})
+
+	It("should not trigger a warning when suppressed", func() {
+		x := 5
+		// ginkgo-linter:ignore-async-assert-warning
+		Eventually(func() bool {
+			Expect(x).To(Equal(5))
+			return true
+		}).Should(BeTrue())
+	})
+})
@@ -0,0 +1,43 @@
+package boolliteral
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("bool literal actual", func() {
+	It("should find tautologies", func() {
+		Expect(true).To(BeTrue())              // want `ginkgo-linter: asserting the true literal; this assertion always passes, and so it checks nothing`
+		Expect(false).To(BeFalse())            // want `ginkgo-linter: asserting the false literal; this assertion always passes, and so it checks nothing`
+		Expect(true).ToNot(BeFalse())          // want `ginkgo-linter: asserting the true literal; this assertion always passes, and so it checks nothing`
+		Expect(true).Should(Equal(true))       // want `ginkgo-linter: asserting the true literal; this assertion always passes, and so it checks nothing`
+		Ω(false).Should(Not(BeTrue()))         // want `ginkgo-linter: asserting the false literal; this assertion always passes, and so it checks nothing`
+		ExpectWithOffset(1, true).To(BeTrue()) // want `ginkgo-linter: asserting the true literal; this assertion always passes, and so it checks nothing`
+	})
+
+	It("should find contradictions", func() {
+		Expect(true).To(BeFalse())        // want `ginkgo-linter: asserting the true literal; this assertion always fails`
+		Expect(false).To(BeTrue())        // want `ginkgo-linter: asserting the false literal; this assertion always fails`
+		Expect(false).ToNot(Equal(false)) // want `ginkgo-linter: asserting the false literal; this assertion always fails`
+	})
+
+	It("should find other matchers", func() {
+		Expect(true).To(BeAssignableToTypeOf(false)) // want `ginkgo-linter: asserting the true literal; this assertion does not check any code`
+	})
+
+	It("should not trigger a warning", func() {
+		b := true
+		Expect(b).To(BeTrue())
+		Expect(!b).To(BeFalse()) // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(b\\)\\.To\\(BeTrue\\(\\)\\)` instead"
+
+		const c = true
+		Expect(c).To(BeTrue())
+	})
+})
+
+var _ = Describe("shadowed true", func() {
+	It("should not trigger a warning for a variable named true", func() {
+		true := false
+		Expect(true).To(BeFalse())
+	})
+})
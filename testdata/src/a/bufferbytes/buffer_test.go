@@ -0,0 +1,31 @@
+package bufferbytes
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("bytes.Buffer Bytes()", func() {
+	It("should trigger a warning", func() {
+		var buf bytes.Buffer
+		buf.WriteString("x")
+		Expect(buf.Bytes()).To(Equal([]byte("x"))) // want "ginkgo-linter: comparing the bytes of a bytes\\.Buffer; compare its String\\(\\) instead, for a readable failure message \\(or use MatchJSON for a JSON content\\)\\. Consider using `Expect\\(buf\\.String\\(\\)\\)\\.To\\(Equal\\(\"x\"\\)\\)` instead"
+
+		expected := []byte("x")
+		Expect(buf.Bytes()).ToNot(Equal(expected[1:])) // want "ginkgo-linter: comparing the bytes of a bytes\\.Buffer; compare its String\\(\\) instead, for a readable failure message \\(or use MatchJSON for a JSON content\\)\\. Consider using `Expect\\(buf\\.String\\(\\)\\)\\.ToNot\\(Equal\\(string\\(expected\\[1:\\]\\)\\)\\)` instead"
+
+		p := bytes.NewBufferString("y")
+		Ω(p.Bytes()).Should(Equal([]byte("y"))) // want "ginkgo-linter: comparing the bytes of a bytes\\.Buffer; compare its String\\(\\) instead, for a readable failure message \\(or use MatchJSON for a JSON content\\)\\. Consider using `Ω\\(p\\.String\\(\\)\\)\\.Should\\(Equal\\(\"y\"\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		var buf bytes.Buffer
+		buf.WriteString("x")
+		Expect(buf.String()).To(Equal("x"))
+		Expect(buf.Bytes()).To(HaveLen(1))
+		Expect(buf.Bytes()).To(ContainElement(byte('x')))
+		Expect([]byte("x")).To(Equal([]byte("x")))
+	})
+})
@@ -34,5 +34,8 @@ var _ = Describe("check cap", func() {
 		Expect(cap(slice) != 10).ToNot(BeTrue())     // want `ginkgo-linter: wrong cap assertion. Consider using .Expect\(slice\)\.To\(HaveCap\(10\)\). instead`
 		Expect(cap(slice) != 10).ToNot(Equal(true))  // want `ginkgo-linter: wrong cap assertion. Consider using .Expect\(slice\)\.To\(HaveCap\(10\)\). instead`
 		Expect(10 != cap(slice)).ToNot(Equal(true))  // want `ginkgo-linter: wrong cap assertion. Consider using .Expect\(slice\)\.To\(HaveCap\(10\)\). instead`
+		Expect(cap(slice) == 0).To(BeFalse())        // want `ginkgo-linter: wrong cap assertion. Consider using .Expect\(cap\(slice\)\)\.ToNot\(BeZero\(\)\). instead`
+		Expect(cap(slice) == 0).To(BeTrue())         // want `ginkgo-linter: wrong cap assertion. Consider using .Expect\(cap\(slice\)\)\.To\(BeZero\(\)\). instead`
+		Expect(cap(slice) != 0).To(BeTrue())         // want `ginkgo-linter: wrong cap assertion. Consider using .Expect\(cap\(slice\)\)\.ToNot\(BeZero\(\)\). instead`
 	})
 })
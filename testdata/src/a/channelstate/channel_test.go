@@ -0,0 +1,26 @@
+package channelstate
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("channel state", func() {
+	ch := make(chan int, 2)
+	s := []int{1}
+
+	It("should trigger a warning", func() {
+		Expect(len(ch)).To(Equal(1)) // want "ginkgo-linter: asserting the length of the ch channel is racy, because the channel state may change concurrently; use Eventually or Consistently instead; e\\.g\\. `Eventually\\(ch\\)\\.Should\\(Receive\\(\\)\\)`"
+		Expect(ch).To(HaveLen(1))    // want "ginkgo-linter: asserting the length of the ch channel is racy, because the channel state may change concurrently; use Eventually or Consistently instead; e\\.g\\. `Eventually\\(ch\\)\\.Should\\(Receive\\(\\)\\)`"
+		Expect(ch).ToNot(BeEmpty())  // want "ginkgo-linter: asserting the length of the ch channel is racy, because the channel state may change concurrently; use Eventually or Consistently instead; e\\.g\\. `Eventually\\(ch\\)\\.Should\\(Receive\\(\\)\\)`"
+		Expect(ch).To(HaveLen(0))    // want "ginkgo-linter: asserting the length of the ch channel is racy, because the channel state may change concurrently; use Eventually or Consistently instead; e\\.g\\. `Eventually\\(ch\\)\\.Should\\(Receive\\(\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(s).To(HaveLen(1))
+		Expect(ch).To(HaveCap(2))
+		Eventually(ch).Should(Receive())
+		Consistently(ch).ShouldNot(Receive())
+		Expect(ch).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,32 @@
+package comparetypes_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const greeting = "hello"
+
+type myBytes []byte
+
+var _ = Describe("compare a byte slice with a string", func() {
+	It("should suggest converting the expected value to a byte slice", func() {
+		b := []byte("hello")
+		Expect(b).To(Equal("hello"))           // want "ginkgo-linter: use Equal with different types: Comparing \\[\\]byte with string; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Expect\\(b\\)\\.To\\(Equal\\(\\[\\]byte\\(\"hello\"\\)\\)\\)` instead"
+		Ω(b).ShouldNot(Equal("world"))         // want "ginkgo-linter: use Equal with different types: Comparing \\[\\]byte with string; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Ω\\(b\\)\\.ShouldNot\\(Equal\\(\\[\\]byte\\(\"world\"\\)\\)\\)` instead"
+		Expect(b).To(Not(Equal(greeting[1:]))) // want "ginkgo-linter: use Equal with different types: Comparing \\[\\]byte with string"
+		Expect(b).To(Equal(greeting))          // want "ginkgo-linter: use Equal with different types: Comparing \\[\\]byte with string; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Expect\\(b\\)\\.To\\(Equal\\(\\[\\]byte\\(greeting\\)\\)\\)` instead"
+	})
+
+	It("should not suggest a fix", func() {
+		s := "hello"
+		b := []byte(s)
+		Expect(b).To(Equal(s)) // want "ginkgo-linter: use Equal with different types: Comparing \\[\\]byte with string; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)$"
+
+		mb := myBytes("hello")
+		Expect(mb).To(Equal("hello")) // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.myBytes with string; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)$"
+
+		Expect(b).To(Equal([]byte("hello")))
+		Expect(b).To(BeEquivalentTo("hello"))
+	})
+})
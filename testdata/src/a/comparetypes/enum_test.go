@@ -0,0 +1,50 @@
+package comparetypes_test
+
+import (
+	mt "a/mytypes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type color int
+
+const (
+	red color = iota + 1
+	green
+	blue
+	crimson = red
+)
+
+type level uint8
+
+const (
+	low  level = 1
+	high level = 2
+)
+
+var _ = Describe("compare a named integer type with an integer literal", func() {
+	It("should suggest the named constant", func() {
+		c := green
+		Expect(c).To(Equal(2))   // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.color with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Expect\\(c\\)\\.To\\(Equal\\(green\\)\\)` instead"
+		Ω(c).ShouldNot(Equal(3)) // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.color with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Ω\\(c\\)\\.ShouldNot\\(Equal\\(blue\\)\\)` instead"
+
+		l := high
+		Expect(l).To(Equal(1)) // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.level with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Expect\\(l\\)\\.To\\(Equal\\(low\\)\\)` instead"
+
+		s := mt.StatusActive
+		Expect(s).To(Equal(1)) // want "ginkgo-linter: use Equal with different types: Comparing a/mytypes\\.Status with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)\\. Consider using `Expect\\(s\\)\\.To\\(Equal\\(mt\\.StatusActive\\)\\)` instead"
+	})
+
+	It("should not suggest a fix", func() {
+		c := red
+		Expect(c).To(Equal(1)) // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.color with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)$"
+		Expect(c).To(Equal(7)) // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.color with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)$"
+
+		n := 2
+		Expect(c).To(Equal(n)) // want "ginkgo-linter: use Equal with different types: Comparing a/comparetypes_test\\.color with int; either change the expected value type if possible, or use the BeEquivalentTo\\(\\) matcher, instead of Equal\\(\\)$"
+
+		Expect(c).To(Equal(red))
+		Expect(c).To(BeEquivalentTo(1))
+	})
+})
@@ -0,0 +1,43 @@
+package comparetypes_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type point struct {
+	x, y int
+}
+
+var _ = Describe("compare unrelated types", func() {
+	It("should report a matcher that never matches", func() {
+		a := 5
+		s := "5"
+		f := 1.5
+		p := point{x: 1, y: 2}
+		Expect(f).To(Equal("1.5"))                  // want `ginkgo-linter: use Equal with unrelated types: Comparing float64 with string; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(s).ToNot(Equal(f))                   // want `ginkgo-linter: use Equal with unrelated types: Comparing string with float64; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(true).To(Equal(1))                   // want `ginkgo-linter: use Equal with unrelated types: Comparing bool with int; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(p).To(Equal(5))                      // want `ginkgo-linter: use Equal with unrelated types: Comparing a/comparetypes_test\.point with int; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(&a).To(Equal(&s))                    // want `ginkgo-linter: use Equal with unrelated types: Comparing \*int with \*string; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect([]int{1}).To(Equal([]string{"1"}))   // want `ginkgo-linter: use Equal with unrelated types: Comparing \[\]int with \[\]string; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(a).To(BeIdenticalTo(true))           // want `ginkgo-linter: use BeIdenticalTo with unrelated types: Comparing int with bool; these types can never be equal, so the BeIdenticalTo\(\) matcher never matches`
+		Expect(a).To(Or(Equal(5), Equal([]int{5}))) // want `ginkgo-linter: use Equal with unrelated types: Comparing int with \[\]int; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(&a).To(HaveValue(Equal(mytype(5))))  // want `ginkgo-linter: use Equal with different types: Comparing int with a/comparetypes_test\.mytype; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of Equal\(\)`
+	})
+
+	It("should not report related types as unrelated", func() {
+		var err error = errors.New("5")
+		var i any = 5
+		a := 53
+		s := "5"
+		Expect(i).To(Equal(5))
+		Expect(err).ToNot(Equal("5"))      // want `ginkgo-linter: use Equal with different types: Comparing error with string; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of Equal\(\)`
+		Expect([]byte("5")).To(Equal("5")) // want `ginkgo-linter: use Equal with different types: Comparing \[\]byte with string; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of Equal\(\)`
+		Expect(a).To(Equal("5"))           // want `ginkgo-linter: use Equal with different types: Comparing int with string; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of Equal\(\)`
+		Expect(s).ToNot(BeIdenticalTo(5))  // want `ginkgo-linter: use BeIdenticalTo with different types: Comparing string with int; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of BeIdenticalTo\(\)`
+		Expect(5.0).ToNot(Equal(5))        // want `ginkgo-linter: use Equal with different types: Comparing float64 with int; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of Equal\(\)`
+	})
+})
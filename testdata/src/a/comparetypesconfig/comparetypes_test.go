@@ -23,6 +23,11 @@ var _ = Describe("compare different types", func() {
 
 		c := mytype(5)
 		Expect(a).ShouldNot(Equal(c))
+
+		Expect(int(a)).ShouldNot(Equal(4))
+
+		s := "abc"
+		Expect(s[0]).ShouldNot(Equal("b"))
 	})
 
 	It("compare interfaces", func() {
@@ -128,6 +128,12 @@ var _ = Describe("remove comparison", func() {
 			Expect(len(s) != 5).Should(BeTrue())     // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(HaveLen\(5\)\). instead`
 			Expect(len(s) != 5).ShouldNot(BeFalse()) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(HaveLen\(5\)\). instead`
 			Expect(len(s) != 0).ShouldNot(BeFalse()) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(BeEmpty\(\)\). instead`
+			Expect(len(s) == 0).Should(BeFalse())    // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(BeEmpty\(\)\). instead`
+			Expect(len(s) > 0).Should(BeTrue())      // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(BeEmpty\(\)\). instead`
+			Expect(len(s) > 0).Should(BeFalse())     // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.Should\(BeEmpty\(\)\). instead`
+			Expect(len(s) >= 1).Should(Equal(true))  // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(BeEmpty\(\)\). instead`
+			Expect(0 < len(s)).ShouldNot(BeFalse())  // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.ShouldNot\(BeEmpty\(\)\). instead`
+			Expect(len(s[1:]) > 0).To(BeTrue())      // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\[1:\]\)\.ToNot\(BeEmpty\(\)\). instead`
 			Expect(len(s) < 5).Should(BeTrue())      // want `ginkgo-linter: wrong comparison assertion\. Consider using .Expect\(len\(s\)\)\.Should\(BeNumerically\("<", 5\)\). instead`
 			Expect(len(s) < 5).Should(Equal(true))   // want `ginkgo-linter: wrong comparison assertion\. Consider using .Expect\(len\(s\)\)\.Should\(BeNumerically\("<", 5\)\). instead`
 			Expect(len(s) < 5).ShouldNot(BeFalse())  // want `ginkgo-linter: wrong comparison assertion\. Consider using .Expect\(len\(s\)\)\.Should\(BeNumerically\("<", 5\)\). instead`
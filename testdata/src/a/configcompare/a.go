@@ -1,6 +1,12 @@
 package configcompare
 
 import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -10,6 +16,26 @@ var _ = Describe("", func() {
 		It("should not trigger warning", func() {
 			abcd := "abcd"
 			Expect("abcd" == abcd).To(BeTrue())
+
+			n := 5
+			Expect(n).To(BeNumerically("==", 5))
+
+			elapsed := 500 * time.Millisecond
+			Expect(elapsed).To(BeNumerically("<", 1000000000))
+
+			Expect(abcd).To(ContainSubstring(fmt.Sprintf("bc")))
+
+			Expect(strings.HasPrefix(abcd, "ab")).To(BeTrue())
+			Expect(strings.Contains(abcd, "bc")).To(BeTrue())
+
+			Expect(regexp.MatchString("^ab", abcd)).To(BeTrue())
+			matched := regexp.MustCompile("b+").MatchString(abcd)
+			Expect(matched).To(BeTrue())
+
+			Expect(reflect.DeepEqual([]string{abcd}, []string{"abcd"})).To(BeTrue())
+
+			f := 1.0
+			Expect(f / 2).To(Equal(0.5))
 		})
 	})
 })
@@ -48,6 +48,7 @@ var _ = Describe("check Expect(err).To(BeNil())", func() {
 
 		Expect(errors.New("fake error")).To(Equal(nil)) // want `wrong nil assertion\. Consider using .Expect\(errors\.New\("fake error"\)\)\.To\(BeNil\(\)\). instead`
 		Expect(err).To(BeNil())
+		Expect(errors.New("fake error").Error()).To(Equal("fake error"))
 		ExpectWithOffset(1, err).To(BeNil())
 		Expect(err).To(Not(BeNil()))
 		Expect(err).ToNot(BeNil())
@@ -96,4 +97,8 @@ var _ = Describe("check Expect(err).To(BeNil())", func() {
 		Expect(nil == errFunc()).To(BeTrue())  // want `ginkgo-linter: wrong nil assertion\. Consider using .Expect\(errFunc\(\)\)\.To\(BeNil\(\)\). instead`
 		Expect(errFunc() != nil).To(BeFalse()) // want `ginkgo-linter: wrong nil assertion\. Consider using .Expect\(errFunc\(\)\)\.To\(BeNil\(\)\). instead`
 	})
+
+	It("check Equal with a wrapped error", func() {
+		Expect(errFunc()).ToNot(Equal(fmt.Errorf("wrap: %w", errors.New("inner"))))
+	})
 })
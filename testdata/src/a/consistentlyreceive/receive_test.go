@@ -0,0 +1,45 @@
+package consistentlyreceive
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("channel receive in Consistently", func() {
+	It("should trigger a warning", func() {
+		ch := make(chan int, 10)
+		Consistently(func() int { return <-ch }).Should(Equal(1)) // want `ginkgo-linter: the function of Consistently receives from a channel, in <-ch; the function is called on each polling, so every call drains another value from the channel; receive the value once, before the assertion, or use the channel directly as the actual value`
+
+		Consistently(func() bool { // want `ginkgo-linter: the function of Consistently receives from a channel, in <-ch; the function is called on each polling`
+			select {
+			case v := <-ch:
+				return v > 0
+			default:
+				return true
+			}
+		}).Should(BeTrue())
+
+		ConsistentlyWithOffset(1, func() int { // want `ginkgo-linter: the function of ConsistentlyWithOffset receives from a channel, in <-ch; the function is called on each polling`
+			v, ok := <-ch
+			if !ok {
+				return 0
+			}
+			return v
+		}, time.Second).Should(BeNumerically(">", 0))
+	})
+
+	It("should not trigger a warning", func() {
+		ch := make(chan int, 10)
+		Eventually(func() int { return <-ch }).Should(Equal(1))
+
+		Consistently(func() int { return len(ch) }).Should(BeNumerically("<", 5))
+		Consistently(ch).ShouldNot(Receive())
+
+		Consistently(func() int {
+			go func() { <-ch }()
+			return len(ch)
+		}).Should(BeNumerically("<", 5))
+	})
+})
@@ -0,0 +1,29 @@
+package constantactual
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	size  = 4
+	count = 2
+)
+
+var _ = Describe("constant actual values", func() {
+	It("should trigger a warning", func() {
+		Expect(2 + 2).To(Equal(4))                      // want `ginkgo-linter: the actual value \(2 \+ 2\) is a constant expression, and the expected value is also a constant; this assertion is computed at compile time, and it does not test any code`
+		Expect(size * count).ToNot(Equal(7))            // want `ginkgo-linter: the actual value \(size \* count\) is a constant expression, and the expected value is also a constant`
+		Ω((size + 1) % 3).Should(BeNumerically("<", 3)) // want `ginkgo-linter: the actual value \(\(size \+ 1\) % 3\) is a constant expression, and the expected value is also a constant`
+		Expect("a" + "b").To(Equal("ab"))               // want `ginkgo-linter: the actual value \("a" \+ "b"\) is a constant expression, and the expected value is also a constant`
+		Expect(0.1 + 0.2).To(Equal(0.3))                // want `ginkgo-linter: the actual value \(0\.1 \+ 0\.2\) is a constant expression, and the expected value is also a constant`
+	})
+
+	It("should not trigger a warning", func() {
+		x := 2
+		Expect(x + 2).To(Equal(4))
+		Expect(size).To(Equal(4))
+		Expect(2 + 2).To(Equal(x + 2))
+		Expect(size * count).To(BeNumerically(">", x))
+	})
+})
@@ -0,0 +1,32 @@
+package containelementfield
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type user struct {
+	Name string
+	Age  int
+}
+
+var _ = Describe("ContainElement(Equal()) of a struct literal", func() {
+	It("should trigger a warning", func() {
+		users := []user{{Name: "a", Age: 3}, {Name: "b", Age: 4}}
+		Expect(users).To(ContainElement(Equal(user{Name: "a", Age: 3}))) // want "ginkgo-linter: ContainElement\\(Equal\\(\\)\\) of a struct literal requires all the fields of the element to match; to find the element by its distinguishing field, consider using HaveField; e\\.g\\. `ContainElement\\(HaveField\\(\"Name\", \"a\"\\)\\)`"
+		Ω(users).ShouldNot(ContainElement(Equal(user{Age: 5})))          // want "ginkgo-linter: ContainElement\\(Equal\\(\\)\\) of a struct literal requires all the fields of the element to match; to find the element by its distinguishing field, consider using HaveField; e\\.g\\. `ContainElement\\(HaveField\\(\"Age\", 5\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		users := []user{{Name: "a", Age: 3}, {Name: "b", Age: 4}}
+		expected := user{Name: "a", Age: 3}
+		Expect(users).To(ContainElement(expected))
+		Expect(users).To(ContainElement(user{Name: "a", Age: 3}))
+		Expect(users).To(ContainElement(Equal(expected)))
+		Expect(users).To(ContainElement(Equal(user{"a", 3})))
+		Expect(users).To(ContainElement(Equal(user{})))
+		Expect(users).To(ContainElement(HaveField("Name", "a")))
+		Expect(users).To(ContainElement(Not(Equal(user{Name: "a"}))))
+		Expect([]int{1, 2}).To(ContainElement(Equal(1)))
+	})
+})
@@ -0,0 +1,38 @@
+package contextdone
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type wrapper struct {
+	context.Context
+}
+
+var _ = Describe("assertions of the Done() channel of a context", func() {
+	It("should trigger a warning", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Eventually(ctx.Done()).Should(Receive()) // want "ginkgo-linter: ctx\\.Done\\(\\) is closed when the context is done, and it never receives a value; the Receive matcher fails for a closed channel; use the BeClosed matcher instead\\. Consider using `Eventually\\(ctx\\.Done\\(\\)\\)\\.Should\\(BeClosed\\(\\)\\)` instead"
+		Expect(ctx.Done()).ToNot(Receive())      // want "ginkgo-linter: ctx\\.Done\\(\\) is closed when the context is done, and it never receives a value; the Receive matcher fails for a closed channel; use the BeClosed matcher instead\\. Consider using `Expect\\(ctx\\.Done\\(\\)\\)\\.ToNot\\(BeClosed\\(\\)\\)` instead"
+		Expect(ctx.Done()).ToNot(BeNil())        // want `ginkgo-linter: ctx\.Done\(\) is a channel, that is closed when the context is done; assert it with the BeClosed matcher, instead of BeNil`
+
+		w := wrapper{Context: ctx}
+		Eventually(w.Done()).Should(Receive()) // want "ginkgo-linter: w\\.Done\\(\\) is closed when the context is done, and it never receives a value; the Receive matcher fails for a closed channel; use the BeClosed matcher instead\\. Consider using `Eventually\\(w\\.Done\\(\\)\\)\\.Should\\(BeClosed\\(\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Eventually(ctx.Done()).Should(BeClosed())
+		Expect(ctx.Err()).To(MatchError(context.Canceled))
+
+		ch := make(chan struct{}, 1)
+		ch <- struct{}{}
+		Eventually(ch).Should(Receive())
+	})
+})
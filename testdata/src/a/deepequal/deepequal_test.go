@@ -0,0 +1,45 @@
+package deepequal
+
+import (
+	"reflect"
+	r "reflect"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type point struct {
+	X, Y int
+}
+
+func getPoint() point {
+	return point{X: 1, Y: 2}
+}
+
+func deepEqual(a, b any) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+var _ = Describe("reflect.DeepEqual assertions", func() {
+	It("should trigger a warning", func() {
+		p := point{X: 1, Y: 2}
+		s := []int{1, 2}
+		Expect(reflect.DeepEqual(p, point{X: 1, Y: 2})).To(BeTrue()) // want "ginkgo-linter: wrong reflect\\.DeepEqual assertion; use the Equal matcher instead, for a better failure message\\. Consider using `Expect\\(p\\)\\.To\\(Equal\\(point\\{X: 1, Y: 2\\}\\)\\)` instead"
+		Expect(reflect.DeepEqual(s, []int{2, 1})).To(BeFalse())      // want "ginkgo-linter: wrong reflect\\.DeepEqual assertion; use the Equal matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.ToNot\\(Equal\\(\\[\\]int\\{2, 1\\}\\)\\)` instead"
+		Expect(reflect.DeepEqual(getPoint(), p)).Should(Equal(true)) // want "ginkgo-linter: wrong reflect\\.DeepEqual assertion; use the Equal matcher instead, for a better failure message\\. Consider using `Expect\\(getPoint\\(\\)\\)\\.Should\\(Equal\\(p\\)\\)` instead"
+		Ω(r.DeepEqual(s, []int{1})).ShouldNot(BeTrue())              // want "ginkgo-linter: wrong reflect\\.DeepEqual assertion; use the Equal matcher instead, for a better failure message\\. Consider using `Ω\\(s\\)\\.ShouldNot\\(Equal\\(\\[\\]int\\{1\\}\\)\\)` instead"
+		Expect(reflect.DeepEqual(p, getPoint())).NotTo(BeFalse())    // want "ginkgo-linter: wrong reflect\\.DeepEqual assertion; use the Equal matcher instead, for a better failure message\\. Consider using `Expect\\(p\\)\\.To\\(Equal\\(getPoint\\(\\)\\)\\)` instead"
+		Expect(reflect.DeepEqual(p, getPoint())).To(Equal(false))    // want "ginkgo-linter: wrong reflect\\.DeepEqual assertion; use the Equal matcher instead, for a better failure message\\. Consider using `Expect\\(p\\)\\.ToNot\\(Equal\\(getPoint\\(\\)\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		p := point{X: 1, Y: 2}
+		var s []int
+		Expect(p).To(Equal(point{X: 1, Y: 2}))
+		Expect(deepEqual(p, getPoint())).To(BeTrue())
+		Expect(reflect.DeepEqual(s, nil)).To(BeFalse())
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(reflect.DeepEqual(p, getPoint())).To(BeTrue())
+	})
+})
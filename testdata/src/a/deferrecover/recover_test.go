@@ -0,0 +1,54 @@
+package deferrecover
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func mayPanic() {
+	panic("boom")
+}
+
+var _ = Describe("defer and recover", func() {
+	It("should trigger a warning for a recovered variable", func() {
+		defer func() {
+			r := recover()
+			Expect(r).To(Equal("boom")) // want "ginkgo-linter: asserting the result of recover\\(\\) in a deferred function; use the Panic or the PanicWith matchers instead; e\\.g\\. `Expect\\(func\\(\\) \\{ mayPanic\\(\\) \\}\\)\\.To\\(PanicWith\\(value\\)\\)`"
+		}()
+		mayPanic()
+	})
+
+	It("should trigger a warning for a recover() actual", func() {
+		defer func() {
+			Ω(recover()).ShouldNot(BeNil()) // want "ginkgo-linter: asserting the result of recover\\(\\) in a deferred function"
+		}()
+		mayPanic()
+	})
+
+	It("should trigger a warning in an if statement", func() {
+		defer func() {
+			if r := recover(); r != nil {
+				Expect(r).To(Equal("boom")) // want "ginkgo-linter: asserting the result of recover\\(\\) in a deferred function"
+			}
+		}()
+		mayPanic()
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(mayPanic).To(PanicWith("boom"))
+		Expect(func() { mayPanic() }).To(Panic())
+
+		x := 5
+		defer func() {
+			Expect(x).To(Equal(5))
+		}()
+
+		func() {
+			defer func() {
+				r := recover()
+				GinkgoWriter.Println(r)
+			}()
+			mayPanic()
+		}()
+	})
+})
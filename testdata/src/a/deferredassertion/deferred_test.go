@@ -0,0 +1,36 @@
+package deferredassertion
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getValue() int {
+	return 1
+}
+
+var _ = Describe("deferred assertions", func() {
+	It("should trigger a warning", func() {
+		x := 0
+		defer Expect(x).To(Equal(1))                  // want "ginkgo-linter: deferred assertion; the actual value and the matcher are evaluated at the defer statement, and only the assertion itself runs at the end of the function; use DeferCleanup, or assert inline; e\\.g\\. `DeferCleanup\\(func\\(\\) \\{ Expect\\(x\\)\\.To\\(Equal\\(1\\)\\) \\}\\)`"
+		defer Ω(getValue()).Should(Equal(1))          // want `ginkgo-linter: deferred assertion; the actual value and the matcher are evaluated at the defer statement`
+		defer Eventually(getValue).Should(Equal(1))   // want `ginkgo-linter: deferred assertion; the actual value and the matcher are evaluated at the defer statement`
+		defer Expect(x).WithOffset(1).ToNot(BeZero()) // want `ginkgo-linter: deferred assertion; the actual value and the matcher are evaluated at the defer statement`
+		x = 1
+	})
+
+	It("should not trigger a warning", func() {
+		x := 0
+		defer func() {
+			Expect(x).To(Equal(1))
+		}()
+
+		DeferCleanup(func() {
+			Expect(x).To(Equal(1))
+		})
+
+		defer GinkgoRecover()
+		x = 1
+		Expect(x).To(Equal(1))
+	})
+})
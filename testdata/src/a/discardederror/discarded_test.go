@@ -0,0 +1,47 @@
+package discardederror
+
+import (
+	"os"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func multi() (int, string, error) {
+	return 1, "a", nil
+}
+
+var _ = Describe("discarded error", func() {
+	It("should trigger a warning", func() {
+		v, _ := strconv.Atoi("5")
+		Expect(v).To(Equal(5)) // want "ginkgo-linter: asserting v, while the error returned with it by strconv\\.Atoi is discarded; assert the error too; e\\.g\\. `Expect\\(err\\)\\.ToNot\\(HaveOccurred\\(\\)\\)`"
+
+		var n int
+		n, _ = strconv.Atoi("6")
+		Expect(n + 1).To(Equal(7)) // want "ginkgo-linter: asserting n, while the error returned with it by strconv\\.Atoi is discarded; assert the error too; e\\.g\\. `Expect\\(err\\)\\.ToNot\\(HaveOccurred\\(\\)\\)`"
+
+		_, s, _ := multi()
+		Expect(s).To(Equal("a")) // want "ginkgo-linter: asserting s, while the error returned with it by multi is discarded; assert the error too; e\\.g\\. `Expect\\(err\\)\\.ToNot\\(HaveOccurred\\(\\)\\)`"
+
+		data, _ := os.ReadFile("file")
+		Expect(string(data)).To(BeEmpty()) // want "ginkgo-linter: asserting data, while the error returned with it by os\\.ReadFile is discarded; assert the error too; e\\.g\\. `Expect\\(err\\)\\.ToNot\\(HaveOccurred\\(\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		v, err := strconv.Atoi("5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal(5))
+
+		x, _ := strconv.Atoi("5")
+		y := x + 1
+		Expect(y).To(Equal(6))
+
+		m := map[string]int{"a": 1}
+		one, _ := m["a"]
+		Expect(one).To(Equal(1))
+
+		w, _ := strconv.Atoi("5")
+		Expect(v).To(Equal(w - 0))
+	})
+})
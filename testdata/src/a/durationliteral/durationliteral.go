@@ -0,0 +1,29 @@
+package durationliteral
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("duration literals", func() {
+	elapsed := 500 * time.Millisecond
+
+	It("should suggest time.Duration units", func() {
+		Expect(elapsed).To(BeNumerically("<", 1000000000))      // want `ginkgo-linter: comparing a time\.Duration value with a raw nanoseconds literal \(1000000000\); use time\.Duration units instead; e\.g\. time\.Second`
+		Expect(elapsed).To(BeNumerically(">=", 500000000))      // want `ginkgo-linter: comparing a time\.Duration value with a raw nanoseconds literal \(500000000\); use time\.Duration units instead; e\.g\. 500 \* time\.Millisecond`
+		Expect(elapsed).ToNot(BeNumerically(">", 120000000000)) // want `ginkgo-linter: comparing a time\.Duration value with a raw nanoseconds literal \(120000000000\); use time\.Duration units instead; e\.g\. 2 \* time\.Minute`
+		Expect(elapsed).To(BeNumerically("<", 1500))            // want `ginkgo-linter: comparing a time\.Duration value with a raw nanoseconds literal \(1500\); use time\.Duration units instead; e\.g\. 1500 \* time\.Nanosecond`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(elapsed).To(BeNumerically("<", time.Second))
+		Expect(elapsed).To(BeNumerically(">", 0))
+		Expect(elapsed).To(BeNumerically("<", 10*time.Second))
+		Expect(int64(elapsed)).To(BeNumerically("<", 1000000000))
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(elapsed).To(BeNumerically("<", 1000000000))
+	})
+})
@@ -0,0 +1,51 @@
+package elementof
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type color string
+
+const (
+	red   color = "red"
+	green color = "green"
+	blue  color = "blue"
+)
+
+type myInt int
+
+type obj struct {
+	name string
+}
+
+var _ = Describe("chain of equality comparisons", func() {
+	x, a, b, c := 1, 2, 3, 4
+	var i64 int64 = 5
+	col := red
+	o := obj{name: "a"}
+	var f float64 = 1.5
+	var n myInt = 1
+
+	It("should suggest BeElementOf", func() {
+		Expect(x == a || x == b || x == c).To(BeTrue())                // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(x\)\.To\(BeElementOf\(a, b, c\)\). instead`
+		Expect(x == 1 || x == 2).To(BeTrue())                          // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(x\)\.To\(BeElementOf\(1, 2\)\). instead`
+		Expect(a == x || x == b).Should(BeFalse())                     // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(x\)\.ShouldNot\(BeElementOf\(a, b\)\). instead`
+		Expect((x == a) || (x == b)).To(Equal(true))                   // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(x\)\.To\(BeElementOf\(a, b\)\). instead`
+		Expect(x == a || x == b).ToNot(BeTrue())                       // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(x\)\.ToNot\(BeElementOf\(a, b\)\). instead`
+		Expect(col == red || col == green || col == blue).To(BeTrue()) // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(col\)\.To\(BeElementOf\(red, green, blue\)\). instead`
+		Expect(o.name == "a" || o.name == "b").To(BeTrue())            // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(o\.name\)\.To\(BeElementOf\("a", "b"\)\). instead`
+		Expect(f == 1.5 || f == 2.5).To(BeTrue())                      // want `ginkgo-linter: wrong comparison chain assertion\. Consider using .Expect\(f\)\.To\(BeElementOf\(1\.5, 2\.5\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(x == a || b == c).To(BeTrue())
+		Expect(x == a || x != b).To(BeTrue())
+		Expect(x == a && x == b).To(BeFalse())
+		Expect(i64 == 1 || i64 == 2).To(BeTrue()) // the untyped constants are int, and will not match the int64 value
+		Expect(f == 1 || f == 2).To(BeTrue())     // the untyped constants are int, and will not match the float64 value
+		Expect(n == 1 || n == 2).To(BeTrue())     // the untyped constants are int, and will not match the myInt value
+		Expect(x == a).To(BeTrue())               // want `ginkgo-linter: wrong comparison assertion\. Consider using .Expect\(x\)\.To\(Equal\(a\)\). instead`
+		Expect(x == a || x == b || x > c).To(BeTrue())
+	})
+})
@@ -0,0 +1,45 @@
+package equivalentto
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type myInt int
+
+type item struct {
+	name string
+}
+
+var _ = Describe("BeEquivalentTo of values of the same type", func() {
+	It("should trigger a warning", func() {
+		x, y := 1, 2
+		Expect(x).To(BeEquivalentTo(1))               // want `ginkgo-linter: use Equal instead of BeEquivalentTo, to compare values of the same type\. Consider using .Expect\(x\)\.To\(Equal\(1\)\). instead`
+		Expect(x).ToNot(BeEquivalentTo(y))            // want `ginkgo-linter: use Equal instead of BeEquivalentTo, to compare values of the same type\. Consider using .Expect\(x\)\.ToNot\(Equal\(y\)\). instead`
+		Expect(x).To(Not(BeEquivalentTo(y)))          // want `ginkgo-linter: use Equal instead of BeEquivalentTo, to compare values of the same type\. Consider using .Expect\(x\)\.ToNot\(Equal\(y\)\). instead`
+		Ω("a").Should(BeEquivalentTo("a"))            // want `ginkgo-linter: use Equal instead of BeEquivalentTo, to compare values of the same type\. Consider using .Ω\("a"\)\.Should\(Equal\("a"\)\). instead`
+		Expect(myInt(1)).To(BeEquivalentTo(myInt(1))) // want `ginkgo-linter: use Equal instead of BeEquivalentTo, to compare values of the same type\. Consider using .Expect\(myInt\(1\)\)\.To\(Equal\(myInt\(1\)\)\). instead`
+
+		i := item{name: "a"}
+		Expect(i).To(BeEquivalentTo(item{name: "a"})) // want `ginkgo-linter: use Equal instead of BeEquivalentTo, to compare values of the same type\. Consider using .Expect\(i\)\.To\(Equal\(item\{name: "a"\}\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		x := 1
+		Expect(x).To(Equal(1))
+
+		var n myInt = 1
+		Expect(n).To(BeEquivalentTo(1))
+
+		var i64 int64 = 1
+		Expect(i64).To(BeEquivalentTo(x))
+
+		var a interface{} = 1
+		Expect(a).To(BeEquivalentTo(1))
+
+		Expect([]byte("a")).To(BeEquivalentTo("a"))
+
+		px1, px2 := &x, &x
+		Expect(px1).To(BeEquivalentTo(px2))
+	})
+})
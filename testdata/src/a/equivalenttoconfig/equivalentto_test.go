@@ -0,0 +1,14 @@
+package equivalenttoconfig
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BeEquivalentTo of values of the same type", func() {
+	It("should not trigger a warning, when suppressed", func() {
+		x, y := 1, 2
+		Expect(x).To(BeEquivalentTo(1))
+		Expect(x).ToNot(BeEquivalentTo(y))
+	})
+})
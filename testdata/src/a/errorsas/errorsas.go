@@ -0,0 +1,28 @@
+package errorsas
+
+import (
+	"errors"
+	"io/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("errors.As", func() {
+	var err error = &fs.PathError{Op: "open", Path: "/fake", Err: fs.ErrNotExist}
+
+	It("should warn about the side effect", func() {
+		var pathErr *fs.PathError
+		Expect(errors.As(err, &pathErr)).To(BeTrue())        // want `ginkgo-linter: asserting errors.As, that also populates &pathErr as a side effect; consider calling errors.As explicitly before the assertion, or use the MatchError matcher`
+		Expect(errors.As(err, &pathErr)).ToNot(BeFalse())    // want `ginkgo-linter: asserting errors.As, that also populates &pathErr as a side effect; consider calling errors.As explicitly before the assertion, or use the MatchError matcher`
+		Expect(errors.As(err, &pathErr)).Should(Equal(true)) // want `ginkgo-linter: asserting errors.As, that also populates &pathErr as a side effect; consider calling errors.As explicitly before the assertion, or use the MatchError matcher`
+		Expect(pathErr.Path).To(Equal("/fake"))
+	})
+
+	It("should not trigger a warning", func() {
+		var pathErr *fs.PathError
+		ok := errors.As(err, &pathErr)
+		Expect(ok).To(BeTrue())
+		Expect(pathErr.Path).To(Equal("/fake"))
+	})
+})
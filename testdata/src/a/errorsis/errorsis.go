@@ -0,0 +1,43 @@
+package errorsis
+
+import (
+	"errors"
+	goerrors "errors"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var errFake = errors.New("fake error")
+
+var _ = Describe("errors.Is", func() {
+	err := io.EOF
+
+	It("should suggest MatchError", func() {
+		Expect(errors.Is(err, io.EOF)).To(BeTrue())              // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .Expect\(err\)\.To\(MatchError\(io\.EOF\)\). instead`
+		Expect(errors.Is(err, errFake)).To(BeFalse())            // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .Expect\(err\)\.ToNot\(MatchError\(errFake\)\). instead`
+		Expect(errors.Is(err, errFake)).ToNot(BeTrue())          // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .Expect\(err\)\.ToNot\(MatchError\(errFake\)\). instead`
+		Expect(errors.Is(err, io.EOF)).Should(Equal(true))       // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .Expect\(err\)\.Should\(MatchError\(io\.EOF\)\). instead`
+		Ω(errors.Is(err, io.EOF)).Should(Not(BeFalse()))         // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .Ω\(err\)\.Should\(MatchError\(io\.EOF\)\). instead`
+		ExpectWithOffset(1, errors.Is(err, io.EOF)).To(BeTrue()) // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .ExpectWithOffset\(1, err\)\.To\(MatchError\(io\.EOF\)\). instead`
+	})
+
+	It("should resolve errors.Is by import path", func() {
+		Expect(goerrors.Is(err, io.EOF)).To(BeTrue()) // want `ginkgo-linter: wrong errors.Is assertion\. Consider using .Expect\(err\)\.To\(MatchError\(io\.EOF\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(err).To(MatchError(io.EOF))
+		Expect(errors.Is(err, io.EOF)).To(Equal(someBool()))
+		Expect(is(err, io.EOF)).To(BeTrue())
+	})
+})
+
+func someBool() bool {
+	return true
+}
+
+func is(err, target error) bool {
+	return errors.Is(err, target)
+}
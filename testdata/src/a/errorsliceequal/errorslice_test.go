@@ -0,0 +1,50 @@
+package errorsliceequal
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type myErr struct{}
+
+func (*myErr) Error() string {
+	return "my error"
+}
+
+type errList []error
+
+var errNotFound = errors.New("not found")
+
+func validate() []error {
+	return []error{fmt.Errorf("validation: %w", errNotFound)}
+}
+
+var _ = Describe("slice of errors", func() {
+	It("should trigger a warning", func() {
+		errs := validate()
+		Expect(errs).To(Equal([]error{errNotFound}))          // want "ginkgo-linter: comparing a slice of errors with Equal; the errors are compared with reflect.DeepEqual, that does not support wrapped errors; consider asserting each error with MatchError, e\\.g\\. `ContainElement\\(MatchError\\(expected\\)\\)`"
+		Expect(errs).To(ConsistOf(errNotFound))               // want "ginkgo-linter: comparing a slice of errors with ConsistOf; the errors are compared with reflect.DeepEqual, that does not support wrapped errors; consider asserting each error with MatchError, e\\.g\\. `ContainElement\\(MatchError\\(expected\\)\\)`"
+		Expect(validate()).ToNot(Equal([]error{errNotFound})) // want "ginkgo-linter: comparing a slice of errors with Equal; the errors are compared with reflect.DeepEqual, that does not support wrapped errors; consider asserting each error with MatchError, e\\.g\\. `ContainElement\\(MatchError\\(expected\\)\\)`"
+
+		list := errList(errs)
+		Expect(list).To(Equal(errList{errNotFound})) // want "ginkgo-linter: comparing a slice of errors with Equal; the errors are compared with reflect.DeepEqual, that does not support wrapped errors; consider asserting each error with MatchError, e\\.g\\. `ContainElement\\(MatchError\\(expected\\)\\)`"
+
+		myErrs := []*myErr{{}}
+		Expect(myErrs).To(HaveLen(1))
+		Expect(myErrs).To(ConsistOf(&myErr{})) // want "ginkgo-linter: comparing a slice of errors with ConsistOf; the errors are compared with reflect.DeepEqual, that does not support wrapped errors; consider asserting each error with MatchError, e\\.g\\. `ContainElement\\(MatchError\\(expected\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		errs := validate()
+		Expect(errs).To(ContainElement(MatchError(errNotFound)))
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(errNotFound))
+
+		names := []string{"a"}
+		Expect(names).To(Equal([]string{"a"}))
+		Expect(names).To(ConsistOf("a"))
+	})
+})
@@ -0,0 +1,46 @@
+package errorstring
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type myErr struct{}
+
+func (*myErr) Error() string {
+	return "my error"
+}
+
+// notAnError has an Error method, but with another signature, so it does not implement error
+type notAnError struct{}
+
+func (notAnError) Error(code int) string {
+	return "not an error"
+}
+
+func getErr() error {
+	return errors.New("boom")
+}
+
+var _ = Describe("Equal assertions of the Error() string of an error", func() {
+	It("should trigger a warning", func() {
+		err := getErr()
+		Expect(err.Error()).To(Equal("boom"))            // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its Error\\(\\) string\\. Consider using `Expect\\(err\\)\\.To\\(MatchError\\(\"boom\"\\)\\)` instead"
+		Expect(err.Error()).ToNot(Equal("bang"))         // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its Error\\(\\) string\\. Consider using `Expect\\(err\\)\\.ToNot\\(MatchError\\(\"bang\"\\)\\)` instead"
+		Ω(getErr().Error()).Should(Equal("boom"))        // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its Error\\(\\) string\\. Consider using `Ω\\(getErr\\(\\)\\)\\.Should\\(MatchError\\(\"boom\"\\)\\)` instead"
+		Expect((&myErr{}).Error()).To(Equal("my error")) // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its Error\\(\\) string\\. Consider using `Expect\\(&myErr\\{\\}\\)\\.To\\(MatchError\\(\"my error\"\\)\\)` instead"
+
+		msg := "boom"
+		Expect(err.Error()).To(Equal(msg)) // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its Error\\(\\) string\\. Consider using `Expect\\(err\\)\\.To\\(MatchError\\(msg\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		err := getErr()
+		Expect(err).To(MatchError("boom"))
+		Expect(err.Error()).To(ContainSubstring("bo"))
+		Expect(notAnError{}.Error(1)).To(Equal("not an error"))
+		Expect(err.Error()).To(Equal(any("boom")))
+	})
+})
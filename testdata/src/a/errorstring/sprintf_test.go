@@ -0,0 +1,25 @@
+package errorstring
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Equal assertions of an error, formatted by fmt.Sprintf", func() {
+	It("should trigger a warning", func() {
+		err := getErr()
+		Expect(fmt.Sprintf("%v", err)).To(Equal("boom"))          // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its fmt\\.Sprintf formatted string\\. Consider using `Expect\\(err\\)\\.To\\(MatchError\\(\"boom\"\\)\\)` instead"
+		Expect(fmt.Sprintf("%s", getErr())).ToNot(Equal("bang"))  // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its fmt\\.Sprintf formatted string\\. Consider using `Expect\\(getErr\\(\\)\\)\\.ToNot\\(MatchError\\(\"bang\"\\)\\)` instead"
+		Expect(fmt.Sprintf("%v", &myErr{})).To(Equal("my error")) // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its fmt\\.Sprintf formatted string\\. Consider using `Expect\\(&myErr\\{\\}\\)\\.To\\(MatchError\\(\"my error\"\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		err := getErr()
+		Expect(fmt.Sprintf("%+v", err)).To(Equal("boom"))
+		Expect(fmt.Sprintf("error: %v", err)).To(Equal("error: boom"))
+		Expect(fmt.Sprintf("%v", 42)).To(Equal("42"))
+		Expect(fmt.Sprintf("%v", err)).To(ContainSubstring("bo"))
+	})
+})
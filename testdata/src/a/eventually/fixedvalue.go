@@ -0,0 +1,48 @@
+package eventually
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type point struct {
+	x, y int
+}
+
+var _ = Describe("async assertion of a fixed value", func() {
+	It("should trigger a warning", func() {
+		x := 5
+		Eventually(x).Should(Equal(5))                 // want `ginkgo-linter: Eventually receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+		Eventually(x).WithTimeout(0).Should(Equal(5))  // want `ginkgo-linter: Eventually receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+		Consistently("abc").Should(HaveLen(3))         // want `ginkgo-linter: Consistently receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+		Eventually(point{x: 1}).Should(Equal(point{})) // want `ginkgo-linter: Eventually receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+		Eventually([2]int{1, 2}).Should(HaveLen(2))    // want `ginkgo-linter: Eventually receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+
+		ctx := context.Background()
+		Eventually(ctx, x).Should(Equal(5)) // want `ginkgo-linter: Eventually receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+	})
+
+	It("should not trigger a warning", func() {
+		x := 5
+		Eventually(func() int { return x }).Should(Equal(5))
+		Eventually(slowInt).Should(Equal(42))
+		Eventually(&x).Should(HaveValue(Equal(5)))
+
+		ch := make(chan int, 1)
+		ch <- 1
+		Eventually(ch).Should(Receive())
+
+		m := map[string]int{"a": 1}
+		Eventually(m).Should(HaveKey("a"))
+
+		s := []int{1}
+		Eventually(s).Should(HaveLen(1))
+
+		var f any = slowInt
+		Eventually(f).Should(Equal(42))
+
+		Expect(x).To(Equal(5))
+	})
+})
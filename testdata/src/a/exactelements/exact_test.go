@@ -0,0 +1,53 @@
+package exactelements
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type point struct {
+	x, y int
+}
+
+const two = 2
+
+var _ = Describe("HaveExactElements", func() {
+	It("should trigger a warning", func() {
+		s := []int{1, 2, 3}
+		Expect(s).To(Equal([]int{1, two, 3}))  // want `ginkgo-linter: use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element\. Consider using .Expect\(s\)\.To\(HaveExactElements\(1, two, 3\)\). instead`
+		Expect(s).ToNot(Equal([]int{3, 2, 1})) // want `ginkgo-linter: use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element\. Consider using .Expect\(s\)\.ToNot\(HaveExactElements\(3, 2, 1\)\). instead`
+
+		strs := []string{"a", "b"}
+		Ω(strs).Should(Equal([]string{"a", "b"})) // want `ginkgo-linter: use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element\. Consider using .Ω\(strs\)\.Should\(HaveExactElements\("a", "b"\)\). instead`
+
+		var i64 int64 = 1
+		nums := []int64{1}
+		Expect(nums).To(Equal([]int64{i64, int64(2)})) // want `ginkgo-linter: use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element\. Consider using .Expect\(nums\)\.To\(HaveExactElements\(i64, int64\(2\)\)\). instead`
+
+		points := []point{{x: 1, y: 2}}
+		Expect(points).To(Equal([]point{point{x: 1, y: 2}})) // want `ginkgo-linter: use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element\. Consider using .Expect\(points\)\.To\(HaveExactElements\(point\{x: 1, y: 2\}\)\). instead`
+
+		anys := []any{1, "a"}
+		Expect(anys).To(Equal([]any{1, "a"})) // want `ginkgo-linter: use HaveExactElements instead of Equal with a slice literal, for a better failure message of each element\. Consider using .Expect\(anys\)\.To\(HaveExactElements\(1, "a"\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		s := []int{1, 2, 3}
+		Expect(s).To(HaveExactElements(1, 2, 3))
+		Expect(s).To(Equal(s))
+		Expect([]int{}).To(Equal([]int{}))
+		Expect(s).To(Equal([]int{0: 1, 1: 2, 2: 3}))
+
+		nums := []int64{1, 2}
+		Expect(nums).To(Equal([]int64{1, 2}))
+
+		points := []point{{x: 1, y: 2}}
+		Expect(points).To(Equal([]point{{x: 1, y: 2}}))
+
+		ptrs := []*int{nil}
+		Expect(ptrs).To(Equal([]*int{nil}))
+
+		arr := [2]int{1, 2}
+		Expect(arr).To(Equal([2]int{1, 2}))
+	})
+})
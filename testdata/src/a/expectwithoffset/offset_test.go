@@ -0,0 +1,35 @@
+package expectwithoffset
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func checkValue(x int) {
+	ExpectWithOffset(1, x).To(Equal(5))        // want "ginkgo-linter: ExpectWithOffset is the old offset API; use Expect\\(\\.\\.\\.\\)\\.WithOffset\\(\\.\\.\\.\\) instead, or call GinkgoHelper\\(\\) in the helper function, and use Expect\\. Consider using `Expect\\(x\\)\\.WithOffset\\(1\\)\\.To\\(Equal\\(5\\)\\)` instead"
+	ExpectWithOffset(2, x).ShouldNot(BeZero()) // want "ginkgo-linter: ExpectWithOffset is the old offset API; .* Consider using `Expect\\(x\\)\\.WithOffset\\(2\\)\\.ShouldNot\\(BeZero\\(\\)\\)` instead"
+}
+
+func checkAsync(f func() int, offset int) {
+	EventuallyWithOffset(1, f).Should(Equal(5))                   // want "ginkgo-linter: EventuallyWithOffset is the old offset API; .* Consider using `Eventually\\(f\\)\\.WithOffset\\(1\\)\\.Should\\(Equal\\(5\\)\\)` instead"
+	ConsistentlyWithOffset(1, f, time.Second).ShouldNot(BeZero()) // want "ginkgo-linter: ConsistentlyWithOffset is the old offset API; .* Consider using `Consistently\\(f, time\\.Second\\)\\.WithOffset\\(1\\)\\.ShouldNot\\(BeZero\\(\\)\\)` instead"
+	EventuallyWithOffset(offset, f).Should(Equal(5))              // want `ginkgo-linter: EventuallyWithOffset is the old offset API; use Eventually\(\.\.\.\)\.WithOffset\(\.\.\.\) instead, or call GinkgoHelper\(\) in the helper function, and use Eventually$`
+	ExpectWithOffset(offset, f()).To(Equal(0))                    // want `ginkgo-linter: ExpectWithOffset is the old offset API; use Expect\(\.\.\.\)\.WithOffset\(\.\.\.\) instead`
+}
+
+func checkWithHelper(x int) {
+	GinkgoHelper()
+	Expect(x).To(Equal(5))
+	Expect(x).WithOffset(1).To(Equal(5))
+	Eventually(func() int { return x }).WithOffset(1).Should(Equal(5))
+}
+
+var _ = Describe("actual functions with offset", func() {
+	It("should use the helpers", func() {
+		checkValue(5)
+		checkAsync(func() int { return 5 }, 1)
+		checkWithHelper(5)
+	})
+})
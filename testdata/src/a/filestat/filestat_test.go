@@ -0,0 +1,41 @@
+package filestat
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("assertions of os.Stat results", func() {
+	It("should trigger a warning", func() {
+		info, err := os.Stat("/tmp")
+		Expect(err).ToNot(HaveOccurred()) // want "ginkgo-linter: asserting err, the result of os\\.Stat\\(\"/tmp\"\\); use the BeAnExistingFile matcher instead, for a better failure message; e\\.g\\. `Expect\\(\"/tmp\"\\)\\.To\\(BeAnExistingFile\\(\\)\\)`$"
+		Expect(info.IsDir()).To(BeTrue()) // want "ginkgo-linter: asserting info\\.IsDir\\(\\), the result of os\\.Stat\\(\"/tmp\"\\); use the BeADirectory matcher instead, for a better failure message; e\\.g\\. `Expect\\(\"/tmp\"\\)\\.To\\(BeADirectory\\(\\)\\)`$"
+
+		path := "/etc/hosts"
+		fi, statErr := os.Stat(path)
+		Expect(statErr).To(Succeed())              // want "ginkgo-linter: asserting statErr, the result of os\\.Stat\\(path\\); use the BeAnExistingFile matcher instead"
+		Expect(fi.Mode().IsRegular()).To(BeTrue()) // want "ginkgo-linter: asserting fi\\.Mode\\(\\)\\.IsRegular\\(\\), the result of os\\.Stat\\(path\\); use the BeARegularFile matcher instead"
+		Expect(fi.IsDir()).ToNot(BeFalse())        // want "ginkgo-linter: asserting fi\\.IsDir\\(\\), the result of os\\.Stat\\(path\\); use the BeADirectory matcher instead" "ginkgo-linter: avoid double negative assertion"
+	})
+
+	It("should not trigger a warning", func() {
+		info, err := os.Stat("/tmp")
+		Expect(err).To(HaveOccurred())
+		Expect(info.IsDir()).To(BeFalse())
+		Expect(info.Name()).To(Equal("tmp"))
+
+		info, err = os.Lstat("/tmp")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.IsDir()).To(BeTrue())
+
+		_, err = os.Stat("/tmp")
+		err = nil
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect("/tmp").To(BeADirectory())
+		Expect("/etc/hosts").To(BeARegularFile())
+		Expect("/etc/hosts").To(BeAnExistingFile())
+	})
+})
@@ -0,0 +1,41 @@
+package floatequal
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type ratio float64
+
+const half = 0.5
+
+var _ = Describe("Equal of a computed float", func() {
+	It("should trigger a warning", func() {
+		a, b := 1.0, 2.0
+		Expect(a / b).To(Equal(0.5))           // want `ginkgo-linter: comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically\("~", 0\.5\) instead`
+		Expect(a*b + 1).ToNot(Equal(half))     // want `ginkgo-linter: comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically\("~", half\) instead`
+		Ω(math.Sqrt(a + b)).Should(Equal(1.7)) // want `ginkgo-linter: comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically\("~", 1\.7\) instead`
+
+		var f32 float32 = 0.1
+		Expect(f32 * 3).To(Equal(float32(0.3))) // want `ginkgo-linter: comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically\("~", float32\(0\.3\)\) instead`
+
+		r := ratio(0.1)
+		Expect(r + r).To(Equal(ratio(0.2))) // want `ginkgo-linter: comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically\("~", ratio\(0\.2\)\) instead`
+	})
+
+	It("should not trigger a warning", func() {
+		a, b := 1.0, 2.0
+		Expect(a).To(Equal(1.0))
+		Expect(a / b).To(BeNumerically("~", 0.5))
+		Expect(math.Sqrt(b)).To(BeNumerically(">", 1.0))
+
+		x, y := 1, 2
+		Expect(x + y).To(Equal(3))
+		Expect(float64(x / y)).To(Equal(0.0))
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(a / b).To(Equal(0.5))
+	})
+})
@@ -0,0 +1,42 @@
+package floatequalall
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type ratio float64
+
+const pi = 3.14
+
+var _ = Describe("Equal of a float value", func() {
+	It("should trigger a warning", func() {
+		x := 3.14
+		Expect(x).To(Equal(3.14))                // want `ginkgo-linter: comparing float values with Equal is fragile, because of rounding errors; use BeNumerically\("~", 3\.14\) instead`
+		Expect(x).ToNot(Equal(pi))               // want `ginkgo-linter: comparing float values with Equal is fragile, because of rounding errors; use BeNumerically\("~", pi\) instead`
+		Ω(x).Should(Equal(2.5))                  // want `ginkgo-linter: comparing float values with Equal is fragile, because of rounding errors; use BeNumerically\("~", 2\.5\) instead`
+		Expect(float32(x)).To(Equal(float32(1))) // want `ginkgo-linter: comparing float values with Equal is fragile, because of rounding errors; use BeNumerically\("~", float32\(1\)\) instead`
+
+		r := ratio(0.1)
+		Expect(r).To(Equal(ratio(0.1))) // want `ginkgo-linter: comparing float values with Equal is fragile, because of rounding errors; use BeNumerically\("~", ratio\(0\.1\)\) instead`
+
+		y := 2.0
+		Expect(x * y).To(Equal(6.28)) // want `ginkgo-linter: comparing a computed float value with Equal is fragile, because of rounding errors; use BeNumerically\("~", 6\.28\) instead`
+	})
+
+	It("should not trigger a warning", func() {
+		x := 3.14
+		Expect(x).To(BeNumerically("~", 3.14))
+		Expect(x).To(BeNumerically(">", 3.0))
+
+		n := 3
+		Expect(n).To(Equal(3))
+		Expect(n + 1).To(Equal(4))
+
+		var i interface{} = 3.14
+		Expect(i).To(Equal(3.14))
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(x).To(Equal(3.14))
+	})
+})
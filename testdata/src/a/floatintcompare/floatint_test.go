@@ -0,0 +1,33 @@
+package floatintcompare
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("float actual values, compared with integer values", func() {
+	It("should trigger a warning", func() {
+		var obj map[string]any
+		Expect(json.Unmarshal([]byte(`{"id": 9007199254740993}`), &obj)).To(Succeed())
+
+		var id int64 = 9007199254740993
+		Expect(obj["id"].(float64)).To(BeNumerically("==", id)) // want "ginkgo-linter: comparing a float64 actual value with an integer expected value \\(int64\\), using BeNumerically; integers above 2\\^53 lose precision when they are converted to float; e\\.g\\. JSON numbers that are unmarshalled into an interface\\{\\} value are float64; consider unmarshalling into an integer field, or using json\\.Number"
+
+		Expect(obj["id"].(float64)).To(Equal(id)) // want "ginkgo-linter: multiple issues: comparing a float64 actual value with an integer expected value \\(int64\\), using Equal"
+
+		var f float32
+		var n uint
+		Expect(f).To(BeNumerically(">", n)) // want `ginkgo-linter: comparing a float32 actual value with an integer expected value \(uint\), using BeNumerically`
+	})
+
+	It("should not trigger a warning", func() {
+		var f float64
+		var id int64
+		Expect(f).To(BeNumerically("==", 5))
+		Expect(f).To(Equal(float64(id)))
+		Expect(id).To(BeNumerically("==", id))
+		Expect(f).To(BeNumerically("<", f))
+	})
+})
@@ -51,5 +51,9 @@ var _ = Describe("should ignore", func() {
 		FIt("should warn", func() {
 			Expect("abcd").Should(HaveLen(4))
 		})
+
+		FSpecify("should warn", func() {
+			Expect("abcd").Should(HaveLen(4))
+		})
 	})
 })
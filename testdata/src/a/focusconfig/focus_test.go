@@ -51,6 +51,14 @@ var _ = Describe("should ignore", func() {
 		FIt("should warn", func() { // want `ginkgo-linter: Focus container found. This is used only for local debug and should not be part of the actual source code\. Consider to replace with "It"`
 			Expect("abcd").Should(HaveLen(4))
 		})
+
+		FSpecify("should warn", func() { // want `ginkgo-linter: Focus container found. This is used only for local debug and should not be part of the actual source code\. Consider to replace with "Specify"`
+			Expect("abcd").Should(HaveLen(4))
+		})
+
+		Specify("should ignore", Focus, func() { // want `ginkgo-linter: Focus spec found. This is used only for local debug and should not be part of the actual source code\. Consider to remove it`
+			Expect("abcd").Should(HaveLen(4))
+		})
 	})
 })
 
@@ -0,0 +1,29 @@
+package forceexpect
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("force Expect", func() {
+	x := 5
+
+	It("should trigger a warning", func() {
+		Ω(x).Should(Equal(5))    // want `ginkgo-linter: must not use Ω; use Expect instead\. Consider using .Expect\(x\)\.Should\(Equal\(5\)\). instead`
+		Ω(x).ShouldNot(BeZero()) // want `ginkgo-linter: must not use Ω; use Expect instead\. Consider using .Expect\(x\)\.ShouldNot\(BeZero\(\)\). instead`
+		Ω(x).To(Equal(5))        // want `ginkgo-linter: must not use Ω; use Expect instead\. Consider using .Expect\(x\)\.To\(Equal\(5\)\). instead`
+		Ω(x == 5).To(BeTrue())   // want `ginkgo-linter: multiple issues: must not use Ω; use Expect instead; wrong comparison assertion\. Consider using .Expect\(x\)\.To\(Equal\(5\)\). instead`
+	})
+
+	It("should trigger a warning with a gomega variable", func() {
+		g := NewWithT(GinkgoT())
+		g.Ω(x).Should(Equal(5)) // want `ginkgo-linter: must not use Ω; use Expect instead\. Consider using .g\.Expect\(x\)\.Should\(Equal\(5\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(x).To(Equal(5))
+		Expect(x).Should(Equal(5))
+		ExpectWithOffset(1, x).To(Equal(5))
+		Eventually(func() int { return x }).Should(Equal(5))
+	})
+})
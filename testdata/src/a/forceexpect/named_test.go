@@ -0,0 +1,16 @@
+package forceexpect
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("force Expect, with named import", func() {
+	It("should trigger a warning", func() {
+		gomega.Ω(5).Should(gomega.Equal(5)) // want `ginkgo-linter: must not use Ω; use Expect instead\. Consider using .gomega\.Expect\(5\)\.Should\(gomega\.Equal\(5\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		gomega.Expect(5).To(gomega.Equal(5))
+	})
+})
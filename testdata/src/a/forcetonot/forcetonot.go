@@ -0,0 +1,50 @@
+package forcetonot
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("force ToNot", func() {
+	var (
+		x   *int
+		err = errors.New("fake error")
+	)
+
+	It("should move the negation to the assertion method", func() {
+		Expect(x).To(Not(BeNil()))                    // want `ginkgo-linter: use ToNot instead of wrapping the matcher with Not\. Consider using .Expect\(x\)\.ToNot\(BeNil\(\)\). instead`
+		Expect(x).Should(Not(BeNil()))                // want `ginkgo-linter: use ShouldNot instead of wrapping the matcher with Not\. Consider using .Expect\(x\)\.ShouldNot\(BeNil\(\)\). instead`
+		Ω(x).Should(Not(BeNil()))                     // want `ginkgo-linter: use ShouldNot instead of wrapping the matcher with Not\. Consider using .Ω\(x\)\.ShouldNot\(BeNil\(\)\). instead`
+		ExpectWithOffset(1, x).To(Not(BeNil()))       // want `ginkgo-linter: use ToNot instead of wrapping the matcher with Not\. Consider using .ExpectWithOffset\(1, x\)\.ToNot\(BeNil\(\)\). instead`
+		Expect(err).To(Not(MatchError("some error"))) // want `ginkgo-linter: use ToNot instead of wrapping the matcher with Not\. Consider using .Expect\(err\)\.ToNot\(MatchError\("some error"\)\). instead`
+	})
+
+	It("should remove double negation", func() {
+		Expect(x).ToNot(Not(BeNil()))     // want `ginkgo-linter: use To instead of wrapping the matcher with Not\. Consider using .Expect\(x\)\.To\(BeNil\(\)\). instead`
+		Expect(x).NotTo(Not(BeNil()))     // want `ginkgo-linter: use To instead of wrapping the matcher with Not\. Consider using .Expect\(x\)\.To\(BeNil\(\)\). instead`
+		Expect(x).ShouldNot(Not(BeNil())) // want `ginkgo-linter: use Should instead of wrapping the matcher with Not\. Consider using .Expect\(x\)\.Should\(BeNil\(\)\). instead`
+	})
+
+	It("should work with async assertions", func() {
+		Eventually(func() *int { return x }).Should(Not(BeNil()))      // want `ginkgo-linter: use ShouldNot instead of wrapping the matcher with Not\. Consider using .Eventually\(func\(\) \*int \{ return x \}\)\.ShouldNot\(BeNil\(\)\). instead`
+		Consistently(func() *int { return x }).ShouldNot(Not(BeNil())) // want `ginkgo-linter: use Should instead of wrapping the matcher with Not\. Consider using .Consistently\(func\(\) \*int \{ return x \}\)\.Should\(BeNil\(\)\). instead`
+	})
+
+	It("should be reported together with other rules", func() {
+		Expect(len("abc")).To(Not(Equal(3))) // want `ginkgo-linter: multiple issues: use ToNot instead of wrapping the matcher with Not; wrong length assertion\. Consider using .Expect\("abc"\)\.ToNot\(HaveLen\(3\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(x).ToNot(BeNil())
+		Expect(x).To(BeNil())
+		Expect("abc").To(And(HaveLen(3), Not(BeEmpty())))
+		Eventually(func() *int { return x }).ShouldNot(BeNil())
+	})
+
+	It("should not trigger a warning for multiple Not() wrappers", func() {
+		Expect(x).To(Not(Not(BeNil())))
+		Expect(x).ToNot(Not(Not(BeNil())))
+	})
+})
@@ -0,0 +1,38 @@
+package gomegawrapper
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+type helper struct {
+	Gomega
+	name string
+}
+
+func newHelper(t *testing.T) *helper {
+	return &helper{Gomega: NewWithT(t), name: "test"}
+}
+
+type notGomega struct{}
+
+func (notGomega) Expect(actual any) bool {
+	return actual != nil
+}
+
+func TestGomegaWrapper(t *testing.T) {
+	h := newHelper(t)
+
+	x := make([]int, 0)
+	h.Expect(len(x)).To(Equal(0)) // want `ginkgo-linter: wrong length assertion\. Consider using .h\.Expect\(x\)\.To\(BeEmpty\(\)\). instead`
+
+	var p *int
+	h.Expect(p == nil).To(BeTrue()) // want `ginkgo-linter: wrong nil assertion\. Consider using .h\.Expect\(p\)\.To\(BeNil\(\)\). instead`
+
+	hv := helper{Gomega: NewWithT(t)}
+	hv.Expect(len(x)).To(BeZero()) // want `ginkgo-linter: wrong length assertion\. Consider using .hv\.Expect\(x\)\.To\(BeEmpty\(\)\). instead`
+
+	n := notGomega{}
+	_ = n.Expect(len(x))
+}
@@ -0,0 +1,56 @@
+package goroutinevar
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("goroutine shared variables", func() {
+	It("should trigger a warning", func() {
+		done := false
+		count := 0
+		go func() {
+			defer GinkgoRecover()
+			done = true
+			count++
+		}()
+		Expect(done).To(BeTrue())      // want `ginkgo-linter: asserting done, that is written by a goroutine started before, with no synchronization; this is a data race; use Eventually with a synchronized getter instead; e\.g\. a getter that locks a mutex`
+		Expect(count + 1).To(Equal(2)) // want `ginkgo-linter: asserting count, that is written by a goroutine started before, with no synchronization; this is a data race; use Eventually with a synchronized getter instead; e\.g\. a getter that locks a mutex`
+	})
+
+	It("should not trigger a warning after waiting", func() {
+		var wg sync.WaitGroup
+		done := false
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			done = true
+		}()
+		wg.Wait()
+		Expect(done).To(BeTrue())
+	})
+
+	It("should not trigger a warning after a channel receive", func() {
+		ch := make(chan struct{})
+		done := false
+		go func() {
+			done = true
+			close(ch)
+		}()
+		<-ch
+		Expect(done).To(BeTrue())
+	})
+
+	It("should not trigger a warning for local variables of the goroutine", func() {
+		done := false
+		go func() {
+			local := 0
+			local++
+			_ = local
+		}()
+		Expect(done).To(BeFalse())
+		Eventually(func() bool { return done }).Should(BeFalse())
+	})
+})
@@ -0,0 +1,32 @@
+package havecap0
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const EMPTY = 0
+
+var _ = Describe("test HaveCap(0)", func() {
+	It("should warn about HaveCap(0)", func() {
+		x := make([]int, 0)
+		Expect(x).To(HaveCap(0))     // want `ginkgo-linter: wrong cap assertion; a zero capacity is rarely meaningful, because it only means an unbuffered channel, or a slice with no allocated space; if the length is meant, use BeEmpty\(\) instead`
+		Expect(x).To(HaveCap(EMPTY)) // want `ginkgo-linter: wrong cap assertion; a zero capacity is rarely meaningful, because it only means an unbuffered channel, or a slice with no allocated space; if the length is meant, use BeEmpty\(\) instead`
+
+		ch := make(chan int)
+		Ω(ch).Should(HaveCap(0)) // want `ginkgo-linter: wrong cap assertion; a zero capacity is rarely meaningful, because it only means an unbuffered channel, or a slice with no allocated space; if the length is meant, use BeEmpty\(\) instead`
+
+		y := make([]int, 0, 5)
+		Expect(y).ToNot(HaveCap(0))   // want `ginkgo-linter: wrong cap assertion; a zero capacity is rarely meaningful, because it only means an unbuffered channel, or a slice with no allocated space; if the length is meant, use BeEmpty\(\) instead`
+		Expect(y).To(Not(HaveCap(0))) // want `ginkgo-linter: wrong cap assertion; a zero capacity is rarely meaningful, because it only means an unbuffered channel, or a slice with no allocated space; if the length is meant, use BeEmpty\(\) instead`
+	})
+
+	It("should not trigger a warning", func() {
+		y := make([]int, 0, 5)
+		Expect(y).To(HaveCap(5))
+		Expect(y).To(BeEmpty())
+
+		// ginkgo-linter:ignore-len-assert-warning
+		Expect(y).ToNot(HaveCap(0))
+	})
+})
@@ -0,0 +1,16 @@
+package havecap0
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("test HaveCap(0)", func() {
+	It("should not warn about HaveCap(0)", func() {
+		x := make([]int, 0)
+		Expect(x).To(HaveCap(0))
+
+		ch := make(chan int)
+		Expect(ch).To(HaveCap(0))
+	})
+})
@@ -0,0 +1,51 @@
+package havekeywithvalue
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type holder struct {
+	values map[string]int
+}
+
+func getMap() map[string]int {
+	return map[string]int{"a": 1}
+}
+
+var _ = Describe("HaveKey followed by Equal", func() {
+	It("should trigger a warning", func() {
+		m := map[string]int{"a": 1, "b": 2}
+		Expect(m).To(HaveKey("a")) // want "ginkgo-linter: the HaveKey assertion of m, followed by the Equal assertion of m\\[\"a\"\\], can be merged into a single HaveKeyWithValue assertion\\. Consider using `Expect\\(m\\)\\.To\\(HaveKeyWithValue\\(\"a\", 1\\)\\)` instead"
+		Expect(m["a"]).To(Equal(1))
+
+		key := "b"
+		h := holder{values: m}
+		Ω(h.values).Should(HaveKey(key)) // want "ginkgo-linter: the HaveKey assertion of h\\.values, followed by the Equal assertion of h\\.values\\[key\\], can be merged into a single HaveKeyWithValue assertion\\. Consider using `Ω\\(h\\.values\\)\\.Should\\(HaveKeyWithValue\\(key, 2\\)\\)` instead"
+		Ω(h.values[key]).Should(Equal(2))
+	})
+
+	It("should not trigger a warning", func() {
+		m := map[string]int{"a": 1, "b": 2}
+		Expect(m).To(HaveKeyWithValue("a", 1))
+
+		Expect(m).To(HaveKey("a"))
+		Expect(m["b"]).To(Equal(2))
+
+		Expect(m).ToNot(HaveKey("c"))
+		Expect(m["c"]).To(Equal(0))
+
+		Expect(m).To(HaveKey("a"))
+		Expect(m["a"]).ToNot(Equal(2))
+
+		Expect(getMap()).To(HaveKey("a"))
+		Expect(getMap()["a"]).To(Equal(1))
+
+		Expect(m).To(HaveKey("a"))
+		m["a"] = 3
+		Expect(m["a"]).To(Equal(3))
+
+		Eventually(m).Should(HaveKey("a"))
+		Expect(m["a"]).To(Equal(3))
+	})
+})
@@ -17,5 +17,8 @@ var _ = Describe("test HaveLen(0)", func() {
 		x = append(x, 1)
 		Expect(x).To(Not(HaveLen(0)))     // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(x\)\.ToNot\(BeEmpty\(\)\). instead`
 		Expect(x).To(Not(HaveLen(EMPTY))) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(x\)\.ToNot\(BeEmpty\(\)\). instead`
+		Expect(x).ToNot(HaveLen(0))       // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(x\)\.ToNot\(BeEmpty\(\)\). instead`
+		Expect(x).NotTo(HaveLen(0))       // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(x\)\.NotTo\(BeEmpty\(\)\). instead`
+		Ω(x).ShouldNot(HaveLen(EMPTY))    // want `ginkgo-linter: wrong length assertion\. Consider using .Ω\(x\)\.ShouldNot\(BeEmpty\(\)\). instead`
 	})
 })
@@ -14,5 +14,7 @@ var _ = Describe("test HaveLen(0)", func() {
 
 		x = append(x, 1)
 		Expect(x).To(Not(HaveLen(0)))
+		Expect(x).ToNot(HaveLen(0))
+		Ω(x).ShouldNot(HaveLen(EMPTY))
 	})
 })
@@ -0,0 +1,38 @@
+package incomparable
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type withSlice struct {
+	s []int
+}
+
+var _ = Describe("comparing incomparable values", func() {
+	It("should trigger a warning", func() {
+		s1 := []int{1, 2}
+		s2 := []int{1, 2}
+		Expect(any(s1) == any(s2)).To(BeTrue())         // want `ginkgo-linter: comparing values of the incomparable type \[\]int panics at runtime; use the Equal matcher instead\. Consider using .Expect\(any\(s1\)\)\.To\(Equal\(any\(s2\)\)\). instead`
+		Expect(any(s1) != any(s2)).To(BeFalse())        // want `ginkgo-linter: comparing values of the incomparable type \[\]int panics at runtime; use the Equal matcher instead\. Consider using .Expect\(any\(s1\)\)\.To\(Equal\(any\(s2\)\)\). instead`
+		Expect(interface{}(s1) == any(s2)).To(BeTrue()) // want `ginkgo-linter: comparing values of the incomparable type \[\]int panics at runtime`
+
+		m := map[string]int{"a": 1}
+		Expect(any(m) == any(m)).To(BeTrue()) // want `ginkgo-linter: comparing values of the incomparable type map\[string\]int panics at runtime`
+
+		w1 := withSlice{s: s1}
+		w2 := withSlice{s: s2}
+		Expect(any(w1) == any(w2)).To(BeTrue()) // want `ginkgo-linter: comparing values of the incomparable type a/incomparable\.withSlice panics at runtime`
+	})
+
+	It("should not trigger a warning", func() {
+		s := []int{1, 2}
+		var a any = s
+		Expect(a == any(1)).To(BeFalse())      // want `ginkgo-linter: wrong comparison assertion`
+		Expect(any(s) == any(1)).To(BeFalse()) // want `ginkgo-linter: wrong comparison assertion`
+		Expect(s).To(Equal([]int{1, 2}))
+
+		x, y := 1, 1
+		Expect(any(x) == any(y)).To(BeTrue()) // want `ginkgo-linter: wrong comparison assertion`
+	})
+})
@@ -0,0 +1,71 @@
+package knownlength
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func fill(s *[]int) error {
+	*s = append(*s, 1)
+	return nil
+}
+
+func getInts() []int {
+	return []int{1, 2}
+}
+
+var _ = Describe("known slice length", func() {
+	It("should trigger a warning", func() {
+		s := []int{}
+		s = append(s, 1)
+		s = append(s, 2, 3)
+		Expect(s).ToNot(BeEmpty()) // want "ginkgo-linter: the length of s is known to be 3; use HaveLen instead of Not\\(BeEmpty\\(\\)\\), to also check the length\\. Consider using `Expect\\(s\\)\\.To\\(HaveLen\\(3\\)\\)` instead"
+	})
+
+	It("should trigger a warning for a nil slice", func() {
+		var s []string
+		s = append(s, "a")
+		s = append(s, "b")
+		Expect(s).To(Not(BeEmpty())) // want "ginkgo-linter: the length of s is known to be 2; use HaveLen instead of Not\\(BeEmpty\\(\\)\\), to also check the length\\. Consider using `Expect\\(s\\)\\.To\\(HaveLen\\(2\\)\\)` instead"
+	})
+
+	It("should trigger a warning for a slice literal", func() {
+		s := []int{1}
+		s = append(s, 2)
+		Expect(s).ShouldNot(BeEmpty()) // want "ginkgo-linter: the length of s is known to be 2; use HaveLen instead of Not\\(BeEmpty\\(\\)\\), to also check the length\\. Consider using `Expect\\(s\\)\\.Should\\(HaveLen\\(2\\)\\)` instead"
+	})
+
+	It("should not trigger a warning if the slice is changed by a function", func() {
+		s := []int{}
+		s = append(s, 1)
+		Expect(fill(&s)).To(Succeed())
+		Expect(s).ToNot(BeEmpty())
+	})
+
+	It("should not trigger a warning if the slice is used", func() {
+		s := []int{}
+		s = append(s, 1)
+		_ = fill(&s)
+		Expect(s).ToNot(BeEmpty())
+	})
+
+	It("should not trigger a warning for non-literal values", func() {
+		s := []int{}
+		v := 1
+		s = append(s, v)
+		s = append(s, getInts()...)
+		Expect(s).ToNot(BeEmpty())
+	})
+
+	It("should not trigger a warning for unknown length", func() {
+		s := getInts()
+		s = append(s, 1)
+		Expect(s).ToNot(BeEmpty())
+	})
+
+	It("should not trigger a warning for a positive assertion", func() {
+		s := []int{}
+		s = append(s, 1)
+		Expect(s).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,28 @@
+package largearrayequal
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type block [2048]byte
+
+var _ = Describe("Equal assertions of large arrays", func() {
+	It("should trigger a warning", func() {
+		var a, b [4096]byte
+		Expect(a).To(Equal(b)) // want "ginkgo-linter: comparing an array of 4096 elements using Equal; the array is copied by value into the assertion; consider comparing slices of the arrays \\(e\\.g\\. `Expect\\(arr\\[:\\]\\)\\.To\\(Equal\\(other\\[:\\]\\)\\)`\\), or pointers to them$"
+
+		var blk block
+		Expect(blk).ToNot(Equal(block{})) // want `ginkgo-linter: comparing an array of 2048 elements using Equal`
+	})
+
+	It("should not trigger a warning", func() {
+		var a, b [1024]byte
+		Expect(a).To(Equal(b))
+
+		var big [4096]byte
+		Expect(big[:]).To(Equal(make([]byte, 4096)))
+		Expect(&big).To(Equal(&big))
+		Expect(big).To(HaveLen(4096))
+	})
+})
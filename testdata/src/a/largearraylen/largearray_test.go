@@ -0,0 +1,18 @@
+package largearraylen
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Equal assertions of large arrays, with a custom length", func() {
+	It("should trigger a warning", func() {
+		var a, b [32]int
+		Expect(a).To(Equal(b)) // want `ginkgo-linter: comparing an array of 32 elements using Equal`
+	})
+
+	It("should not trigger a warning", func() {
+		var a, b [16]int
+		Expect(a).To(Equal(b))
+	})
+})
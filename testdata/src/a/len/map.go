@@ -0,0 +1,41 @@
+package len
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type counter struct {
+	Count int
+}
+
+func (c counter) Len() int {
+	return c.Count
+}
+
+var _ = Describe("test the length of maps", func() {
+	m := map[string]int{"a": 1, "b": 2}
+	obj := counter{Count: 2}
+
+	It("should suggest HaveLen for maps", func() {
+		Expect(len(m)).To(Equal(2))               // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(2\)\). instead`
+		Expect(len(m)).To(BeNumerically("==", 2)) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(2\)\). instead`
+		Expect(len(m) == 2).To(BeTrue())          // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(2\)\). instead`
+		Expect(len(map[int]bool{})).To(Equal(0))  // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(map\[int\]bool\{\}\)\.To\(BeEmpty\(\)\). instead`
+		Expect(len(m)).ToNot(BeZero())            // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.ToNot\(BeEmpty\(\)\). instead`
+	})
+
+	It("should keep a non-literal expected length as is", func() {
+		Expect(len(m)).To(Equal(obj.Count))               // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(obj\.Count\)\). instead`
+		Expect(len(m)).To(Equal(obj.Len()))               // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(obj\.Len\(\)\)\). instead`
+		Expect(len(m)).ToNot(Equal(obj.Count + 1))        // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.ToNot\(HaveLen\(obj\.Count \+ 1\)\). instead`
+		Expect(len(m)).To(BeNumerically("==", obj.Count)) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(obj\.Count\)\). instead`
+		Expect(len(m) == obj.Count).To(BeTrue())          // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(m\)\.To\(HaveLen\(obj\.Count\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(m).To(HaveLen(2))
+		Expect(m).To(HaveLen(obj.Count))
+		Expect(m).ToNot(BeEmpty())
+	})
+})
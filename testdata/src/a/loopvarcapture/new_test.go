@@ -0,0 +1,16 @@
+//go:build go1.22
+
+package loopvarcapture
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("loop variable capture, with go 1.22", func() {
+	for _, tc := range cases {
+		It(tc.name, func() {
+			Expect(tc.got).To(Equal(tc.exp))
+		})
+	}
+})
@@ -0,0 +1,50 @@
+//go:build go1.21
+
+package loopvarcapture
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testCase struct {
+	name     string
+	got, exp int
+}
+
+var cases = []testCase{{name: "a", got: 1, exp: 1}, {name: "b", got: 2, exp: 2}}
+
+var _ = Describe("loop variable capture", func() {
+	for _, tc := range cases {
+		It(tc.name, func() {
+			Expect(tc.got).To(Equal(tc.exp)) // want "ginkgo-linter: the tc loop variable is captured by the It closure; before go 1\\.22, all the specs share the same variable, and see its last value\\. Copy it to a local variable in the loop body; e\\.g\\. `tc := tc`"
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		PIt("index", func() {
+			Expect(i).To(BeNumerically("<", 3)) // want "ginkgo-linter: the i loop variable is captured by the PIt closure; before go 1\\.22, all the specs share the same variable, and see its last value\\. Copy it to a local variable in the loop body; e\\.g\\. `i := i`"
+		})
+	}
+
+	for _, tc := range cases {
+		Specify(tc.name, func() {
+			Expect(tc.got).To(Equal(tc.exp)) // want "ginkgo-linter: the tc loop variable is captured by the Specify closure; before go 1\\.22, all the specs share the same variable, and see its last value\\. Copy it to a local variable in the loop body; e\\.g\\. `tc := tc`"
+		})
+	}
+
+	for i, tc := range cases {
+		tc := tc
+		It(tc.name, func() {
+			Expect(tc.got).To(Equal(tc.exp))
+			Expect(i).To(BeNumerically(">=", 0)) // want "ginkgo-linter: the i loop variable is captured by the It closure; before go 1\\.22, all the specs share the same variable, and see its last value\\. Copy it to a local variable in the loop body; e\\.g\\. `i := i`"
+		})
+	}
+
+	for _, tc := range cases {
+		name := tc.name
+		It(name, func() {
+			Expect(name).ToNot(BeEmpty())
+		})
+	}
+})
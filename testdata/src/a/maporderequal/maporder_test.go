@@ -0,0 +1,57 @@
+package maporderequal
+
+import (
+	"sort"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Equal assertions of a slice, built from a map", func() {
+	It("should trigger a warning", func() {
+		m := map[string]int{"a": 1, "b": 2}
+
+		keys := []string{}
+		for k := range m {
+			keys = append(keys, k)
+		}
+		Expect(keys).To(Equal([]string{"a", "b"})) // want "ginkgo-linter: keys is built by iterating over a map, and so the order of its elements is random; use the ConsistOf matcher instead of Equal\\. Consider using `Expect\\(keys\\)\\.To\\(ConsistOf\\(\\[\\]string\\{\"a\", \"b\"\\}\\)\\)` instead"
+
+		var values []int
+		for _, v := range m {
+			values = append(values, v)
+		}
+		Expect(values).To(HaveLen(2))
+		Expect(values).ToNot(Equal([]int{3, 4})) // want "ginkgo-linter: values is built by iterating over a map, and so the order of its elements is random; use the ConsistOf matcher instead of Equal\\. Consider using `Expect\\(values\\)\\.ToNot\\(ConsistOf\\(\\[\\]int\\{3, 4\\}\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		m := map[string]int{"a": 1, "b": 2}
+
+		keys := []string{}
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		Expect(keys).To(Equal([]string{"a", "b"}))
+
+		s := []int{1, 2}
+		var doubled []int
+		for _, v := range s {
+			doubled = append(doubled, v*2)
+		}
+		Expect(doubled).To(Equal([]int{2, 4}))
+
+		var all []int
+		for _, v := range s {
+			all = append(all, v)
+		}
+		Expect(all).To(Equal([]int{1, 2}))
+
+		var sorted []string
+		for k := range m {
+			sorted = append(sorted, k)
+		}
+		Expect(sorted).To(ConsistOf("a", "b"))
+	})
+})
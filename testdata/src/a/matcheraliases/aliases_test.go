@@ -0,0 +1,26 @@
+package matcheraliases
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("matcher aliases", func() {
+	x := 5
+	var p *int
+	s := []int{1, 2}
+
+	It("should apply the rules through the aliases", func() {
+		Expect(x).To(MyEqual(uint(5)))       // want `ginkgo-linter: use Equal with different types: Comparing int with uint; either change the expected value type if possible, or use the BeEquivalentTo\(\) matcher, instead of Equal\(\)`
+		Expect(p).To(MyEqual(nil))           // want `ginkgo-linter: wrong nil assertion$`
+		Expect(len(s)).ToNot(MyEqual(0))     // want `ginkgo-linter: wrong length assertion$`
+		Expect(x).To(Not(MyEqual([]int{5}))) // want `ginkgo-linter: use Equal with unrelated types: Comparing int with \[\]int; these types can never be equal, so the Equal\(\) matcher never matches`
+		Expect(s).To(Or(BeEmptyList(), MyEqual([]int{1, 2})))
+		Expect(len(s)).To(Equal(0)) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(s\)\.To\(BeEmpty\(\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(x).To(MyEqual(5))
+		Expect(s).ToNot(BeEmptyList())
+	})
+})
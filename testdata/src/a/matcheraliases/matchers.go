@@ -0,0 +1,14 @@
+package matcheraliases
+
+import (
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func MyEqual(expected any) types.GomegaMatcher {
+	return Equal(expected)
+}
+
+func BeEmptyList() types.GomegaMatcher {
+	return BeEmpty()
+}
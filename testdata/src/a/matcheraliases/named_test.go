@@ -0,0 +1,13 @@
+package matcheraliases
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("matcher aliases with named gomega import", func() {
+	ginkgo.It("should apply the rules through the aliases", func() {
+		var p *int
+		gomega.Expect(p).ToNot(MyEqual(nil)) // want `ginkgo-linter: wrong nil assertion$`
+	})
+})
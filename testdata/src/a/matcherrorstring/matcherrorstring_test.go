@@ -0,0 +1,33 @@
+package matcherrorstring
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getErr() error {
+	return errors.New("boom: not found")
+}
+
+var _ = Describe("string matcher assertions of an error message", func() {
+	It("should trigger a warning", func() {
+		err := getErr()
+		Expect(err.Error()).To(ContainSubstring("not found"))       // want "ginkgo-linter: asserting an error as a string, with ContainSubstring; assert the error itself, and wrap the matcher with MatchError, for a better failure message\\. Consider using `Expect\\(err\\)\\.To\\(MatchError\\(ContainSubstring\\(\"not found\"\\)\\)\\)` instead"
+		Expect(err.Error()).ToNot(HavePrefix("bang"))               // want "ginkgo-linter: asserting an error as a string, with HavePrefix; assert the error itself, and wrap the matcher with MatchError, for a better failure message\\. Consider using `Expect\\(err\\)\\.ToNot\\(MatchError\\(HavePrefix\\(\"bang\"\\)\\)\\)` instead"
+		Expect(err.Error()).To(Not(HaveSuffix("bang")))             // want "ginkgo-linter: asserting an error as a string, with HaveSuffix; assert the error itself, and wrap the matcher with MatchError, for a better failure message\\. Consider using `Expect\\(err\\)\\.ToNot\\(MatchError\\(HaveSuffix\\(\"bang\"\\)\\)\\)` instead"
+		Ω(fmt.Sprintf("%v", err)).Should(MatchRegexp(`^boom: .+$`)) // want "ginkgo-linter: asserting an error as a string, with MatchRegexp; assert the error itself, and wrap the matcher with MatchError, for a better failure message\\. Consider using `Ω\\(err\\)\\.Should\\(MatchError\\(MatchRegexp\\(`\\^boom: \\.\\+\\$`\\)\\)\\)` instead"
+		Expect(err.Error()).To(MatchRegexp("^%s: .+$", "boom"))     // want "ginkgo-linter: asserting an error as a string, with MatchRegexp; assert the error itself, and wrap the matcher with MatchError, for a better failure message\\. Consider using `Expect\\(err\\)\\.To\\(MatchError\\(MatchRegexp\\(\"\\^%s: \\.\\+\\$\", \"boom\"\\)\\)\\)` instead"
+		Expect(err.Error()).To(Equal("boom: not found"))            // want "ginkgo-linter: wrong error message assertion; use the MatchError matcher with the error itself, instead of comparing its Error\\(\\) string\\. Consider using `Expect\\(err\\)\\.To\\(MatchError\\(\"boom: not found\"\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		err := getErr()
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		Expect(err.Error()).ToNot(BeEmpty())
+		s := "boom: not found"
+		Expect(s).To(ContainSubstring("not found"))
+	})
+})
@@ -0,0 +1,37 @@
+package matchjson
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const expectedJSON = `{"name": "a", "values": [1, 2]}`
+
+var _ = Describe("Equal with JSON", func() {
+	data, _ := json.Marshal(map[string]int{"a": 1})
+	str := string(data)
+
+	It("should suggest MatchJSON", func() {
+		Expect(string(data)).To(Equal(`{"a":1}`))        // want `ginkgo-linter: use MatchJSON to compare JSON values; the Equal matcher is sensitive to white spaces and to the order of the keys`
+		Expect(str).ToNot(Equal(expectedJSON))           // want `ginkgo-linter: use MatchJSON to compare JSON values; the Equal matcher is sensitive to white spaces and to the order of the keys`
+		Expect(data).To(Equal(` [{"a": 1}, {"a": 2}] `)) // want `ginkgo-linter: use MatchJSON to compare JSON values; the Equal matcher is sensitive to white spaces and to the order of the keys`
+	})
+
+	It("should suggest MatchJSON for a converted JSON constant", func() {
+		raw := json.RawMessage(data)
+		Expect(raw).To(Equal(json.RawMessage(`{"a":1}`))) // want "ginkgo-linter: use MatchJSON to compare JSON values; the Equal matcher is sensitive to white spaces and to the order of the keys\\. Consider using `Expect\\(raw\\)\\.To\\(MatchJSON\\(`\\{\"a\":1\\}`\\)\\)` instead"
+		Expect(data).ToNot(Equal([]byte(expectedJSON)))   // want "ginkgo-linter: use MatchJSON to compare JSON values; .* Consider using `Expect\\(data\\)\\.ToNot\\(MatchJSON\\(expectedJSON\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(str).To(MatchJSON(expectedJSON))
+		Expect(str).To(Equal(`{not json}`))
+		Expect(json.RawMessage(data)).To(Equal(json.RawMessage(`{not json}`)))
+		Expect(json.RawMessage(data)).To(Equal(json.RawMessage(data)))
+		Expect(str).To(Equal(`"a"`))
+		Expect(str).To(Equal("5"))
+		Expect(str).To(Equal("a"))
+	})
+})
@@ -0,0 +1,34 @@
+package methodexpression
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type counter struct {
+	n int
+}
+
+func (c counter) Value() int {
+	return c.n
+}
+
+func (c *counter) Inc() {
+	c.n++
+}
+
+var _ = Describe("method expressions", func() {
+	It("should trigger a warning", func() {
+		Expect(counter.Value).ToNot(BeNil())   // want `ginkgo-linter: the actual value \(counter\.Value\) is a method expression, that is not bound to any receiver; this is probably a mistake; use a method value of a specific receiver, or call the method`
+		Expect((*counter).Inc).ToNot(BeNil())  // want `ginkgo-linter: the actual value \(\(\*counter\)\.Inc\) is a method expression, that is not bound to any receiver`
+		Ω((*counter).Value).ShouldNot(BeNil()) // want `ginkgo-linter: the actual value \(\(\*counter\)\.Value\) is a method expression, that is not bound to any receiver`
+	})
+
+	It("should not trigger a warning", func() {
+		c := &counter{n: 1}
+		Expect(c.Value()).To(Equal(1))
+		Expect(c.Value).ToNot(BeNil())
+		Eventually(c.Value).Should(Equal(1))
+		Expect(c.n).To(Equal(1))
+	})
+})
@@ -11,3 +11,11 @@ func (e MyErr) String() string {
 }
 
 var MyError = &MyErr{}
+
+type Status int
+
+const (
+	StatusUnknown Status = iota
+	StatusActive
+	StatusDone
+)
@@ -0,0 +1,31 @@
+package negatedactual
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func isReady() bool {
+	return true
+}
+
+var _ = Describe("negated actual value", func() {
+	It("should trigger a warning", func() {
+		ready := isReady()
+		Expect(!ready).To(BeTrue())       // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.To\\(BeFalse\\(\\)\\)` instead"
+		Expect(!ready).To(BeFalse())      // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.To\\(BeTrue\\(\\)\\)` instead"
+		Expect(!ready).ToNot(BeTrue())    // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.ToNot\\(BeFalse\\(\\)\\)` instead"
+		Ω(!isReady()).Should(BeTrue())    // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Ω\\(isReady\\(\\)\\)\\.Should\\(BeFalse\\(\\)\\)` instead"
+		Expect(!!ready).To(BeTrue())      // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.To\\(BeTrue\\(\\)\\)` instead"
+		Expect(!(!ready)).To(BeFalse())   // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.To\\(BeFalse\\(\\)\\)` instead"
+		Expect(!!!ready).To(BeTrue())     // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.To\\(BeFalse\\(\\)\\)` instead"
+		Expect(!(ready)).To(BeTrue(), "") // want "ginkgo-linter: avoid negating the actual value of a boolean assertion\\. Consider using `Expect\\(ready\\)\\.To\\(BeFalse\\(\\), \"\"\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		ready := isReady()
+		Expect(ready).To(BeTrue())
+		Expect(ready).To(BeFalse())
+		Expect(-1).To(BeNumerically("<", 0))
+	})
+})
@@ -0,0 +1,33 @@
+package negativeunsigned
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type myUint uint8
+
+const minusOne = -1
+
+var _ = Describe("unsigned compared with a negative constant", func() {
+	var (
+		u  uint  = 5
+		u8 uint8 = 5
+		mu myUint
+		i  = 5
+	)
+
+	It("should trigger a warning", func() {
+		Expect(u).To(BeNumerically(">", -1))       // want `ginkgo-linter: comparing an unsigned value of type uint with a negative constant \(-1\); an unsigned value is never negative, so the result of this assertion is always the same`
+		Expect(u8).ToNot(BeNumerically("<", -0.5)) // want `ginkgo-linter: comparing an unsigned value of type uint8 with a negative constant \(-1/2\); an unsigned value is never negative, so the result of this assertion is always the same`
+		Expect(mu).ToNot(Equal(minusOne))          // want `ginkgo-linter: comparing an unsigned value of type a/negativeunsigned\.myUint with a negative constant \(-1\); an unsigned value is never negative, so the result of this assertion is always the same`
+		Expect(u).To(BeNumerically(">=", -10))     // want `ginkgo-linter: comparing an unsigned value of type uint with a negative constant \(-10\); an unsigned value is never negative, so the result of this assertion is always the same`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(u).To(BeNumerically(">", 1))
+		Expect(u8).To(BeNumerically(">", 0))
+		Expect(i).To(BeNumerically(">", -1))
+		Expect(u).To(BeEquivalentTo(5))
+	})
+})
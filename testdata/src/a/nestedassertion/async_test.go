@@ -0,0 +1,26 @@
+package nestedassertion
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("async assertions of nested assertions", func() {
+	It("should trigger a warning", func() {
+		x := 5
+		Eventually(Expect(x).To(Equal(5))).Should(BeTrue())                              // want "ginkgo-linter: the actual value of Eventually is the result of an assertion \\(Expect\\(\\.\\.\\.\\)\\.To\\(\\.\\.\\.\\)\\), that runs only once, before Eventually starts polling; pass a function with a Gomega argument to Eventually instead; e\\.g\\. `Eventually\\(func\\(g Gomega\\) \\{ g\\.Expect\\(\\.\\.\\.\\)\\.\\.\\. \\}\\)\\.Should\\(Succeed\\(\\)\\)`$"
+		Consistently(Ω(x).ShouldNot(BeZero())).WithTimeout(time.Second).Should(BeTrue()) // want `ginkgo-linter: the actual value of Consistently is the result of an assertion \(Ω\(\.\.\.\)\.ShouldNot\(\.\.\.\)\), that runs only once, before Consistently starts polling`
+
+		g := NewWithT(GinkgoT())
+		g.Eventually(g.Expect(x).To(Equal(5))).Should(BeTrue()) // want `ginkgo-linter: the actual value of Eventually is the result of an assertion \(Expect\(\.\.\.\)\.To\(\.\.\.\)\)`
+	})
+
+	It("should not trigger a warning", func() {
+		x := 5
+		Eventually(func(g Gomega) {
+			g.Expect(x).To(Equal(5))
+		}).Should(Succeed())
+	})
+})
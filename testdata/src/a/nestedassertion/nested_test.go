@@ -0,0 +1,26 @@
+package nestedassertion
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("nested assertions", func() {
+	It("should trigger a warning", func() {
+		x := 5
+		Expect(Expect(x).To(Equal(5))).To(BeTrue())                               // want `ginkgo-linter: the actual value is the result of another assertion \(Expect\(\.\.\.\)\.To\(\.\.\.\)\); gomega already fails the test if this assertion fails, so asserting its result is redundant; use the inner assertion only`
+		Ω(Ω(x).ShouldNot(BeZero())).Should(Equal(true))                           // want `ginkgo-linter: the actual value is the result of another assertion \(Ω\(\.\.\.\)\.ShouldNot\(\.\.\.\)\)`
+		Expect(Eventually(func() int { return x }).Should(Equal(5))).To(BeTrue()) // want `ginkgo-linter: the actual value is the result of another assertion \(Eventually\(\.\.\.\)\.Should\(\.\.\.\)\)`
+
+		g := NewWithT(GinkgoT())
+		g.Expect(g.Expect(x).To(Equal(5))).To(BeTrue()) // want `ginkgo-linter: the actual value is the result of another assertion \(Expect\(\.\.\.\)\.To\(\.\.\.\)\)`
+	})
+
+	It("should not trigger a warning", func() {
+		x := 5
+		Expect(x).To(Equal(5))
+
+		ok := x == 5
+		Expect(ok).To(BeTrue())
+	})
+})
@@ -0,0 +1,54 @@
+package numericbounds
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const five = 5
+
+type counter struct {
+	n int
+}
+
+func getInt() int {
+	return 5
+}
+
+var _ = Describe("complementary BeNumerically bounds", func() {
+	It("should trigger a warning", func() {
+		n := 5
+		Expect(n).To(BeNumerically(">=", 5))
+		Expect(n).To(BeNumerically("<=", 5)) // want "ginkgo-linter: the assertions of n with BeNumerically\\(\">=\", 5\\) and BeNumerically\\(\"<=\", 5\\) together mean that n equals 5; use Equal instead; e\\.g\\. `Expect\\(n\\)\\.To\\(Equal\\(5\\)\\)`"
+
+		c := counter{n: 5}
+		Ω(c.n).Should(BeNumerically("<=", five))
+		Expect(n).ToNot(BeZero())
+		Ω(c.n).Should(BeNumerically(">=", five)) // want "ginkgo-linter: the assertions of c\\.n with BeNumerically\\(\">=\", five\\) and BeNumerically\\(\"<=\", five\\) together mean that c\\.n equals five; use Equal instead; e\\.g\\. `Ω\\(c\\.n\\)\\.Should\\(Equal\\(five\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		n := 5
+		Expect(n).To(BeNumerically(">=", 4))
+		Expect(n).To(BeNumerically("<=", 6))
+
+		Expect(n).To(BeNumerically(">=", 5))
+		n = getInt()
+		Expect(n).To(BeNumerically("<=", 5))
+
+		Expect(getInt()).To(BeNumerically(">=", 5))
+		Expect(getInt()).To(BeNumerically("<=", 5))
+
+		n = getInt()
+		Expect(n).To(BeNumerically(">=", 5))
+		Expect(n).ToNot(BeNumerically("<=", 5))
+
+		f := 5.0
+		Expect(f).To(BeNumerically(">=", 5.0))
+		Expect(f).To(BeNumerically("<=", 5.0))
+
+		var i64 int64 = 5
+		Expect(i64).To(BeNumerically(">=", 5))
+		Expect(i64).To(BeNumerically("<=", 5))
+	})
+})
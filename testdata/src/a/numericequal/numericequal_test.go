@@ -0,0 +1,48 @@
+package numericequal
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type port int
+
+const expected = 5
+
+var _ = Describe("BeNumerically with ==", func() {
+	It("should trigger a warning", func() {
+		n := 5
+		Expect(n).To(BeNumerically("==", 5))       // want `ginkgo-linter: use Equal instead of BeNumerically\("==", \.\.\.\), to compare a non-float value\. Consider using .Expect\(n\)\.To\(Equal\(5\)\). instead`
+		Expect(n).ToNot(BeNumerically("==", 6))    // want `ginkgo-linter: use Equal instead of BeNumerically\("==", \.\.\.\), to compare a non-float value\. Consider using .Expect\(n\)\.ToNot\(Equal\(6\)\). instead`
+		Ω(n).Should(BeNumerically("==", expected)) // want `ginkgo-linter: use Equal instead of BeNumerically\("==", \.\.\.\), to compare a non-float value\. Consider using .Ω\(n\)\.Should\(Equal\(expected\)\). instead`
+
+		var n64 int64 = 5
+		Expect(n64).To(BeNumerically("==", int64(5))) // want `ginkgo-linter: use Equal instead of BeNumerically\("==", \.\.\.\), to compare a non-float value\. Consider using .Expect\(n64\)\.To\(Equal\(int64\(5\)\)\). instead`
+
+		p := port(80)
+		Expect(p).To(BeNumerically("==", port(80))) // want `ginkgo-linter: use Equal instead of BeNumerically\("==", \.\.\.\), to compare a non-float value\. Consider using .Expect\(p\)\.To\(Equal\(port\(80\)\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		n := 5
+		Expect(n).To(Equal(5))
+		Expect(n).To(BeNumerically(">", 4))
+		Expect(n).To(BeNumerically("<=", 5))
+
+		// Equal(5) does not match an int64 value
+		var n64 int64 = 5
+		Expect(n64).To(BeNumerically("==", 5))
+
+		f := 5.0
+		Expect(f).To(BeNumerically("==", 5.0))
+		Expect(f).To(BeNumerically("==", 5))
+
+		m := 5
+		Expect(n).To(BeNumerically("==", m))
+
+		Expect(len([]int{1})).To(BeNumerically("==", 1)) // want `ginkgo-linter: wrong length assertion`
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(n).To(BeNumerically("==", 5))
+	})
+})
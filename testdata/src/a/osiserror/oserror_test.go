@@ -0,0 +1,25 @@
+package osiserror
+
+import (
+	"os"
+	goos "os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("os.IsNotExist", func() {
+	It("should trigger a warning", func() {
+		_, err := os.Stat("not-exist")
+		Expect(os.IsNotExist(err)).To(BeTrue())      // want `ginkgo-linter: os\.IsNotExist does not support wrapped errors, and new code should use errors\.Is instead; use the MatchError matcher, that uses errors\.Is, with os\.ErrNotExist\. Consider using .Expect\(err\)\.To\(MatchError\(os\.ErrNotExist\)\). instead`
+		Expect(os.IsExist(err)).To(BeFalse())        // want `ginkgo-linter: os\.IsExist does not support wrapped errors, and new code should use errors\.Is instead; use the MatchError matcher, that uses errors\.Is, with os\.ErrExist\. Consider using .Expect\(err\)\.ToNot\(MatchError\(os\.ErrExist\)\). instead`
+		Ω(os.IsPermission(err)).ShouldNot(BeTrue())  // want `ginkgo-linter: os\.IsPermission does not support wrapped errors, and new code should use errors\.Is instead; use the MatchError matcher, that uses errors\.Is, with os\.ErrPermission\. Consider using .Ω\(err\)\.ShouldNot\(MatchError\(os\.ErrPermission\)\). instead`
+		Expect(goos.IsNotExist(err)).To(Equal(true)) // want `ginkgo-linter: os\.IsNotExist does not support wrapped errors, and new code should use errors\.Is instead; use the MatchError matcher, that uses errors\.Is, with os\.ErrNotExist\. Consider using .Expect\(err\)\.To\(MatchError\(goos\.ErrNotExist\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		_, err := os.Stat("not-exist")
+		Expect(err).To(MatchError(os.ErrNotExist))
+		Expect(os.IsPathSeparator('/')).To(BeTrue())
+	})
+})
@@ -0,0 +1,16 @@
+package pending
+
+import (
+	tester "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = tester.XDescribe("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "Describe"`
+	tester.PIt("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "It"`
+		Expect("abcd").Should(HaveLen(4))
+	})
+
+	tester.It("should ignore", func() {
+		Expect("abcd").Should(HaveLen(4))
+	})
+})
@@ -0,0 +1,50 @@
+package pending
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = PDescribe("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "Describe"`
+	It("should ignore", func() {
+		Expect("abcd").Should(HaveLen(4))
+	})
+})
+
+var _ = Describe("should ignore", func() {
+	XContext("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "Context"`
+		It("should ignore", func() {
+			Expect("abcd").Should(HaveLen(4))
+		})
+	})
+
+	PWhen("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "When"`
+		PIt("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "It"`
+			Expect("abcd").Should(HaveLen(4))
+		})
+	})
+
+	XIt("placeholder") // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "It"`
+
+	XSpecify("should warn", func() { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "Specify"`
+		Expect("abcd").Should(HaveLen(4))
+	})
+
+	It("should ignore", func() {
+		Expect("abcd").Should(HaveLen(4))
+	})
+
+	XDescribeTable("pending table", func(s string, l int) { // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "DescribeTable"`
+		Expect(s).Should(HaveLen(l))
+	},
+		Entry("not pending", "abcd", 4),
+		PEntry("pending", "abc", 3), // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "Entry"`
+	)
+
+	DescribeTable("table", func(s string, l int) {
+		Expect(s).Should(HaveLen(l))
+	},
+		Entry("not pending", "abcd", 4),
+		XEntry("pending", "abc", 3), // want `ginkgo-linter: Pending container found\. The spec will not run\. Consider to replace with "Entry"`
+	)
+})
@@ -0,0 +1,57 @@
+package protoequal
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// messageState mocks the internal state of a generated protobuf message
+type messageState struct {
+	initialized bool
+}
+
+// reflectMessage mocks the protoreflect.Message interface
+type reflectMessage interface {
+	Interface() any
+}
+
+// Person mocks a generated protobuf message
+type Person struct {
+	state messageState
+	Name  string
+}
+
+func (x *Person) ProtoReflect() reflectMessage { return nil }
+
+// notProto has a ProtoReflect method with a different signature
+type notProto struct {
+	Name string
+}
+
+func (notProto) ProtoReflect(_ int) reflectMessage { return nil }
+
+type plain struct {
+	Name string
+}
+
+var _ = Describe("Equal of protobuf messages", func() {
+	It("should trigger a warning", func() {
+		p1 := &Person{Name: "a"}
+		p2 := &Person{Name: "a"}
+		Expect(p1).To(Equal(p2))           // want "ginkgo-linter: comparing a protobuf message \\(\\*protoequal\\.Person\\) using Equal; protobuf messages hold an internal state, that reflect\\.DeepEqual compares as well; use a proto-aware matcher instead; e\\.g\\. `BeComparableTo\\(expected, protocmp\\.Transform\\(\\)\\)`, or `proto\\.Equal`"
+		Expect(*p1).ToNot(Equal(Person{})) // want `ginkgo-linter: comparing a protobuf message \(protoequal\.Person\) using Equal`
+		Ω(p1).Should(Equal(p2))            // want `ginkgo-linter: comparing a protobuf message \(\*protoequal\.Person\) using Equal`
+	})
+
+	It("should not trigger a warning", func() {
+		p1 := &Person{Name: "a"}
+		p2 := &Person{Name: "a"}
+		Expect(p1.Name).To(Equal(p2.Name))
+		Expect(p1).To(BeIdenticalTo(p1))
+		Expect(notProto{Name: "a"}).To(Equal(notProto{Name: "a"}))
+		Expect(plain{Name: "a"}).To(Equal(plain{Name: "a"}))
+
+		var m interface{ ProtoReflect() reflectMessage } = p1
+		Expect(m).To(Equal(p2))
+	})
+})
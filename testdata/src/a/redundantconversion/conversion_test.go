@@ -0,0 +1,39 @@
+package redundantconversion
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type myInt int
+
+var _ = Describe("redundant type conversions", func() {
+	x, y := 5, 5
+	var d time.Duration
+	var m myInt
+
+	It("should trigger a warning", func() {
+		Expect(int(x)).To(Equal(y))                                   // want `ginkgo-linter: redundant type conversion of the actual value: the value is already of type int\. Consider using .Expect\(x\)\.To\(Equal\(y\)\). instead`
+		Expect(x).To(Equal(int(y)))                                   // want `ginkgo-linter: redundant type conversion in the Equal matcher: the value is already of type int\. Consider using .Expect\(x\)\.To\(Equal\(y\)\). instead`
+		Expect(int(x)).ToNot(BeIdenticalTo(int(y)))                   // want `ginkgo-linter: multiple issues: redundant type conversion of the actual value: the value is already of type int; redundant type conversion in the BeIdenticalTo matcher: the value is already of type int\. Consider using .Expect\(x\)\.ToNot\(BeIdenticalTo\(y\)\). instead`
+		Expect(time.Duration(d)).To(BeEquivalentTo(time.Duration(0))) // want `ginkgo-linter: redundant type conversion of the actual value: the value is already of type time\.Duration\. Consider using .Expect\(d\)\.To\(BeEquivalentTo\(time\.Duration\(0\)\)\). instead`
+		Expect(myInt(m)).To(Equal(m))                                 // want `ginkgo-linter: redundant type conversion of the actual value: the value is already of type a/redundantconversion\.myInt\. Consider using .Expect\(m\)\.To\(Equal\(m\)\). instead`
+	})
+
+	It("should report the converted pointer, and not the conversion", func() {
+		p := &x
+		Expect((*int)(p)).To(Equal(5)) // want `ginkgo-linter: comparing a pointer to a value will always fail\. Consider using .Expect\(\(\*int\)\(p\)\)\.To\(HaveValue\(Equal\(5\)\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(int64(x)).To(Equal(int64(y)))
+		Expect(x).To(Equal(int(5)))
+		Expect(myInt(x)).To(Equal(m))
+		Expect(d).To(BeNumerically("<", time.Duration(x)))
+
+		// ginkgo-linter:ignore-type-compare-warning
+		Expect(int(x)).To(Equal(y))
+	})
+})
@@ -0,0 +1,49 @@
+package redundantsucceed
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func f() error {
+	return nil
+}
+
+func g() error {
+	return errors.New("fake error")
+}
+
+func withValue(i int) (int, error) {
+	return i, nil
+}
+
+var _ = Describe("redundant Succeed", func() {
+	It("should trigger a warning", func() {
+		err := f()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f()).To(Succeed()) // want `ginkgo-linter: the error of f\(\) is already stored in err, and err is checked; asserting f\(\) with Succeed\(\) again is redundant, and calls the function again`
+
+		err2 := g()
+		Ω(g()).Should(Succeed()) // want `ginkgo-linter: the error of g\(\) is already stored in err2, and err2 is checked; asserting g\(\) with Succeed\(\) again is redundant, and calls the function again`
+		Ω(err2).Should(Succeed())
+	})
+
+	It("should not trigger a warning", func() {
+		err := f()
+		Expect(g()).ToNot(Succeed())
+		Expect(f()).To(Succeed())
+		GinkgoWriter.Println(err)
+
+		err2 := f()
+		Expect(err2).ToNot(HaveOccurred())
+		Expect(g()).ToNot(Succeed())
+		Expect(withValue(2)).Error().ToNot(HaveOccurred())
+
+		err3 := f()
+		err3 = g()
+		Expect(err3).To(HaveOccurred())
+		Expect(f()).To(Succeed())
+	})
+})
@@ -0,0 +1,49 @@
+package regexpmatch
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("regexp.MatchString assertions", func() {
+	It("should trigger a warning", func() {
+		s := "foobar"
+		Expect(regexp.MatchString("^foo", s)).To(BeTrue())                 // want "ginkgo-linter: wrong regexp\\.MatchString assertion; use the MatchRegexp matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.To\\(MatchRegexp\\(\"\\^foo\"\\)\\)` instead"
+		Expect(regexp.MatchString("^bar", s)).To(BeFalse())                // want "ginkgo-linter: wrong regexp\\.MatchString assertion; use the MatchRegexp matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.ToNot\\(MatchRegexp\\(\"\\^bar\"\\)\\)` instead"
+		Expect(regexp.MatchString("bar$", s)).Should(Equal(true))          // want "ginkgo-linter: wrong regexp\\.MatchString assertion; use the MatchRegexp matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.Should\\(MatchRegexp\\(\"bar\\$\"\\)\\)` instead"
+		Expect(regexp.MustCompile("o+").MatchString(s)).To(BeTrue())       // want "ginkgo-linter: wrong regexp\\.MustCompile\\(\\.\\.\\.\\)\\.MatchString assertion; use the MatchRegexp matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.To\\(MatchRegexp\\(\"o\\+\"\\)\\)` instead"
+		Expect(regexp.MustCompile("z").MatchString(s)).ShouldNot(BeTrue()) // want "ginkgo-linter: wrong regexp\\.MustCompile\\(\\.\\.\\.\\)\\.MatchString assertion; use the MatchRegexp matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.ShouldNot\\(MatchRegexp\\(\"z\"\\)\\)` instead"
+	})
+
+	It("should trigger a warning with no fix, if the result is assigned to a variable", func() {
+		s := "foobar"
+		matched, err := regexp.MatchString("^foo", s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matched).To(BeTrue()) // want "ginkgo-linter: asserting matched, the result of regexp\\.MatchString; use the MatchRegexp matcher instead, for a better failure message; e\\.g\\. `Expect\\(s\\)\\.To\\(MatchRegexp\\(\"\\^foo\"\\)\\)`$"
+
+		found := regexp.MustCompile("o+").MatchString(s)
+		Expect(found).To(BeTrue()) // want "ginkgo-linter: asserting found, the result of regexp\\.MustCompile\\(\\.\\.\\.\\)\\.MatchString; use the MatchRegexp matcher instead"
+	})
+
+	It("should not trigger a warning", func() {
+		s := "foobar"
+		re := regexp.MustCompile("^foo")
+		Expect(s).To(MatchRegexp("^foo"))
+		Expect(re.MatchString(s)).To(BeTrue())
+		Expect(regexp.MatchString("^foo", s)).Error().ToNot(HaveOccurred())
+
+		matched, err := regexp.MatchString("^foo", s)
+		Expect(err).ToNot(HaveOccurred())
+		matched = len(s) > 3
+		Expect(matched).To(BeTrue())
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(regexp.MatchString("^foo", s)).To(BeTrue())
+
+		found := regexp.MustCompile("o+").MatchString(s)
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(found).To(BeTrue())
+	})
+})
@@ -0,0 +1,37 @@
+package reversedequal
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const answer = 42
+
+func getValue() int {
+	return 42
+}
+
+var _ = Describe("constant actual with a non-constant expected value", func() {
+	It("should trigger a warning", func() {
+		x := 42
+		Expect(42).To(Equal(x))                   // want "ginkgo-linter: the actual value \\(42\\) is a constant, and the expected value is not; the failure message reads backwards; swap the actual and the expected values\\. Consider using `Expect\\(x\\)\\.To\\(Equal\\(42\\)\\)` instead"
+		Expect(answer).ToNot(Equal(x + 1))        // want "ginkgo-linter: the actual value \\(answer\\) is a constant, and the expected value is not; the failure message reads backwards; swap the actual and the expected values\\. Consider using `Expect\\(x \\+ 1\\)\\.ToNot\\(Equal\\(answer\\)\\)` instead"
+		Ω("hello").Should(Equal(greeting()))      // want "ginkgo-linter: the actual value \\(\"hello\"\\) is a constant, and the expected value is not; the failure message reads backwards; swap the actual and the expected values\\. Consider using `Ω\\(greeting\\(\\)\\)\\.Should\\(Equal\\(\"hello\"\\)\\)` instead"
+		Expect(42).Should(Not(Equal(getValue()))) // want "ginkgo-linter: the actual value \\(42\\) is a constant, and the expected value is not; the failure message reads backwards; swap the actual and the expected values\\. Consider using `Expect\\(getValue\\(\\)\\)\\.ShouldNot\\(Equal\\(42\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		x := 42
+		Expect(x).To(Equal(42))
+		Expect(answer).To(BeNumerically("==", x))
+
+		var i64 int64 = 42
+		Expect(42).ToNot(Equal(i64)) // want `ginkgo-linter: use Equal with different types: Comparing int with int64`
+
+		Eventually(42).Should(Equal(x)) // want `ginkgo-linter: Eventually receives a value, that is not a function, a channel or a pointer\. This actually checks nothing, because this value is never changed; wrap it with a function, or use Expect instead`
+	})
+})
+
+func greeting() string {
+	return "hello"
+}
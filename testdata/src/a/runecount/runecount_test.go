@@ -0,0 +1,24 @@
+package runecount
+
+import (
+	"unicode/utf8"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rune count", func() {
+	It("should trigger a warning", func() {
+		s := "héllo"
+		Expect(utf8.RuneCountInString(s)).To(Equal(5))                  // want "ginkgo-linter: utf8\\.RuneCountInString counts runes, and not bytes, so it is different than the length, for non-ASCII values; if the length in bytes is meant, use `Expect\\(s\\)\\.To\\(HaveLen\\(5\\)\\)` instead"
+		Expect(utf8.RuneCountInString(s)).ToNot(BeNumerically("==", 6)) // want "ginkgo-linter: utf8\\.RuneCountInString counts runes, and not bytes, so it is different than the length, for non-ASCII values; if the length in bytes is meant, use `Expect\\(s\\)\\.ToNot\\(HaveLen\\(6\\)\\)` instead"
+		Ω(utf8.RuneCount([]byte(s))).Should(Equal(5))                   // want "ginkgo-linter: utf8\\.RuneCount counts runes, and not bytes, so it is different than the length, for non-ASCII values; if the length in bytes is meant, use `Ω\\(\\[\\]byte\\(s\\)\\)\\.Should\\(HaveLen\\(5\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		s := "héllo"
+		Expect(s).To(HaveLen(6))
+		Expect(utf8.RuneCountInString(s)).To(BeNumerically(">", 4))
+		Expect(utf8.ValidString(s)).To(BeTrue())
+	})
+})
@@ -0,0 +1,54 @@
+package satisfyall
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type holder struct {
+	name string
+}
+
+func getValue() int {
+	return 5
+}
+
+var _ = Describe("multiple assertions of the same actual value", func() {
+	It("should trigger a warning", func() {
+		x := 5
+		Expect(x).To(BeNumerically(">", 0)) // want "ginkgo-linter: the 2 assertions of x can be merged into a single SatisfyAll assertion\\. Consider using `Expect\\(x\\)\\.To\\(SatisfyAll\\(BeNumerically\\(\">\", 0\\), BeNumerically\\(\"<\", 10\\)\\)\\)` instead"
+		Expect(x).To(BeNumerically("<", 10))
+
+		h := holder{name: "abc"}
+		Ω(h.name).Should(HavePrefix("a")) // want "ginkgo-linter: the 3 assertions of h\\.name can be merged into a single SatisfyAll assertion\\. Consider using `Ω\\(h\\.name\\)\\.Should\\(SatisfyAll\\(HavePrefix\\(\"a\"\\), HaveSuffix\\(\"c\"\\), Not\\(BeEmpty\\(\\)\\)\\)\\)` instead"
+		Ω(h.name).Should(HaveSuffix("c"))
+		Ω(h.name).Should(Not(BeEmpty()))
+	})
+
+	It("should not trigger a warning", func() {
+		x := 5
+		Expect(x).To(BeNumerically(">", 0))
+		Expect(x).Should(BeNumerically("<", 10))
+
+		Expect(x).To(BeNumerically(">", 0))
+		Expect(x).ToNot(BeNumerically(">", 10))
+
+		Expect(x).To(BeNumerically(">", 0))
+		x++
+		Expect(x).To(BeNumerically("<", 10))
+
+		Expect(getValue()).To(BeNumerically(">", 0))
+		Expect(getValue()).To(BeNumerically("<", 10))
+
+		Expect(x).To(BeNumerically(">", 0), "x should be positive")
+		Expect(x).To(BeNumerically("<", 10))
+
+		Eventually(getValue).Should(BeNumerically(">", 0))
+		Eventually(getValue).Should(BeNumerically("<", 10))
+
+		y := 3
+		Expect(x).To(BeNumerically(">", 0))
+		Expect(y).To(BeNumerically(">", 0))
+		Expect(x).To(SatisfyAll(BeNumerically(">", 0), BeNumerically("<", 10)))
+	})
+})
@@ -0,0 +1,37 @@
+package shadowedgomega
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type checker struct {
+	values []any
+}
+
+func (c *checker) To(matchers ...any) bool {
+	return len(c.values) > 0
+}
+
+var _ = Describe("shadowed gomega functions", func() {
+	It("should not trigger a warning for a local Expect variable", func() {
+		Expect := func(values ...any) *checker {
+			return &checker{values: values}
+		}
+
+		x := []int{1}
+		Expect(len(x)).To(Equal(0))
+	})
+
+	It("should not trigger a warning for a local Expect type", func() {
+		type Expect = *checker
+
+		c := &checker{values: []any{1}}
+		Expect(c).To(Equal(0))
+	})
+
+	It("should still check the gomega Expect function", func() {
+		x := []int{1}
+		Expect(len(x)).To(Equal(1)) // want `ginkgo-linter: wrong length assertion\. Consider using .Expect\(x\)\.To\(HaveLen\(1\)\). instead`
+	})
+})
@@ -0,0 +1,53 @@
+package sharedmatcher
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("shared matcher", func() {
+	It("should trigger a warning", func() {
+		ch1 := make(chan int, 1)
+		ch2 := make(chan int, 1)
+		ch3 := make(chan int, 1)
+		ch1 <- 1
+		ch2 <- 2
+		ch3 <- 3
+
+		var x int
+		m := Receive(&x)
+		Expect(ch1).To(m)
+		Expect(ch2).To(m) // want `ginkgo-linter: the Receive matcher in m is used by more than one assertion; gomega matchers may keep a state from a previous match, like the Receive matcher; create a new matcher for each assertion`
+		Expect(ch3).ToNot(Not(m)) // want `ginkgo-linter: the Receive matcher in m is used by more than one assertion; gomega matchers may keep a state from a previous match, like the Receive matcher; create a new matcher for each assertion`
+
+		positive := BeNumerically(">", 0)
+		Expect(x).To(positive)
+		Expect(x).Should(SatisfyAll(positive, BeNumerically("<", 10))) // want `ginkgo-linter: the BeNumerically matcher in positive is used by more than one assertion; gomega matchers may keep a state from a previous match, like the Receive matcher; create a new matcher for each assertion`
+	})
+
+	It("should not trigger a warning", func() {
+		ch1 := make(chan int, 1)
+		ch2 := make(chan int, 1)
+		ch1 <- 1
+		ch2 <- 2
+
+		var x int
+		m := Receive(&x)
+		Expect(ch1).To(m)
+		m = Receive(&x)
+		Expect(ch2).To(m)
+
+		Expect(ch1).To(Receive())
+		Expect(ch2).To(Receive())
+
+		notMatcher := Receive()
+		notMatcher = Not(BeNil())
+		Expect(ch1).To(notMatcher)
+		Expect(ch2).To(notMatcher)
+
+		// only in a single assertion
+		eq := Equal(2)
+		Expect(x).To(eq)
+		Expect(x).ToNot(BeZero())
+	})
+})
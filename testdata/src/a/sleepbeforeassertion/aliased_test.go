@@ -0,0 +1,15 @@
+package sleepbeforeassertion
+
+import (
+	tm "time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("sleep before assertion, with aliased imports", func() {
+	It("should trigger a warning", func() {
+		tm.Sleep(tm.Second) // want `ginkgo-linter: time\.Sleep right before an assertion is flaky; use Eventually or Consistently to wait for the expected state, instead of sleeping for a fixed time`
+		gomega.Expect(isReady()).To(gomega.BeTrue())
+	})
+})
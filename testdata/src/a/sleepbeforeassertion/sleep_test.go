@@ -0,0 +1,38 @@
+package sleepbeforeassertion
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func isReady() bool {
+	return true
+}
+
+var _ = Describe("sleep before assertion", func() {
+	It("should trigger a warning", func() {
+		time.Sleep(time.Second) // want `ginkgo-linter: time\.Sleep right before an assertion is flaky; use Eventually or Consistently to wait for the expected state, instead of sleeping for a fixed time`
+		Expect(isReady()).To(BeTrue())
+
+		time.Sleep(100 * time.Millisecond) // want `ginkgo-linter: time\.Sleep right before an assertion is flaky; use Eventually or Consistently to wait for the expected state, instead of sleeping for a fixed time`
+		Ω(isReady()).Should(BeTrue())
+
+		if isReady() {
+			time.Sleep(time.Second) // want `ginkgo-linter: time\.Sleep right before an assertion is flaky; use Eventually or Consistently to wait for the expected state, instead of sleeping for a fixed time`
+			Expect(isReady()).To(BeTrue())
+		}
+	})
+
+	It("should not trigger a warning", func() {
+		time.Sleep(time.Second)
+		Eventually(isReady).Should(BeTrue())
+
+		time.Sleep(time.Second)
+		_ = isReady()
+		Expect(isReady()).To(BeTrue())
+
+		time.Sleep(time.Second)
+	})
+})
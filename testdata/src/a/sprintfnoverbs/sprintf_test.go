@@ -0,0 +1,31 @@
+package sprintfnoverbs
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fmt.Sprintf with no verbs", func() {
+	out := "the task is done"
+	n := 5
+
+	It("should trigger a warning", func() {
+		Expect(out).To(ContainSubstring(fmt.Sprintf("done")))                                  // want `ginkgo-linter: redundant fmt\.Sprintf in the ContainSubstring matcher: the format string has no formatting verbs; use the string as is\. Consider using .Expect\(out\)\.To\(ContainSubstring\("done"\)\). instead`
+		Expect(out).ToNot(Equal(fmt.Sprintf("done")))                                          // want `ginkgo-linter: redundant fmt\.Sprintf in the Equal matcher: the format string has no formatting verbs; use the string as is\. Consider using .Expect\(out\)\.ToNot\(Equal\("done"\)\). instead`
+		Expect(out).To(Not(ContainSubstring(fmt.Sprintf("failed"))))                           // want `ginkgo-linter: redundant fmt\.Sprintf in the ContainSubstring matcher: the format string has no formatting verbs; use the string as is\. Consider using .Expect\(out\)\.ToNot\(ContainSubstring\("failed"\)\). instead`
+		Eventually(func() string { return out }).Should(ContainSubstring(fmt.Sprintf(`done`))) // want "ginkgo-linter: redundant fmt\\.Sprintf in the ContainSubstring matcher: the format string has no formatting verbs; use the string as is\\. Consider using .Eventually\\(func\\(\\) string \\{ return out \\}\\)\\.Should\\(ContainSubstring\\(`done`\\)\\). instead"
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(out).To(ContainSubstring(fmt.Sprintf("%d", n)))
+		Expect(out).To(ContainSubstring(fmt.Sprintf("100%%")))
+		Expect(out).To(ContainSubstring("done"))
+		Expect(out).To(ContainSubstring("%s", "done"))
+		Expect(out).To(HavePrefix(fmt.Sprintf("the")))
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(out).To(ContainSubstring(fmt.Sprintf("done")))
+	})
+})
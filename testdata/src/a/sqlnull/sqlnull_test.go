@@ -0,0 +1,34 @@
+package sqlnull
+
+import (
+	"database/sql"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("sql.Null* values", func() {
+	It("should trigger a warning", func() {
+		name := sql.NullString{String: "x", Valid: true}
+		Expect(name).To(Equal(sql.NullString{String: "x"})) // want "ginkgo-linter: the expected sql\\.NullString value sets String, but not Valid, so it is a NULL value; set `Valid: true`, or assert the String and the Valid fields separately"
+
+		count := sql.NullInt64{Int64: 5, Valid: true}
+		Ω(count).ShouldNot(Equal(sql.NullInt64{Int64: 5})) // want `ginkgo-linter: the expected sql\.NullInt64 value sets Int64, but not Valid, so it is a NULL value`
+
+		v := sql.Null[int]{V: 5, Valid: true}
+		Expect(v).To(Equal(sql.Null[int]{V: 5})) // want `ginkgo-linter: the expected sql\.Null\[int\] value sets V, but not Valid, so it is a NULL value`
+	})
+
+	It("should not trigger a warning", func() {
+		name := sql.NullString{String: "x", Valid: true}
+		Expect(name).To(Equal(sql.NullString{String: "x", Valid: true}))
+		Expect(name).To(Equal(sql.NullString{"x", true}))
+		Expect(name).ToNot(Equal(sql.NullString{}))
+		Expect(name.String).To(Equal("x"))
+		Expect(name.Valid).To(BeTrue())
+
+		expected := sql.NullString{String: "x"}
+		expected.Valid = true
+		Expect(name).To(Equal(expected))
+	})
+})
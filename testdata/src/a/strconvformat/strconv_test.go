@@ -0,0 +1,36 @@
+package strconvformat
+
+import (
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("assertions of strconv string conversions", func() {
+	It("should trigger a warning", func() {
+		n := 5
+		Expect(strconv.Itoa(n)).To(Equal("5"))     // want "ginkgo-linter: asserting the string conversion of n, by strconv\\.Itoa; assert the numeric value itself, instead; e\\.g\\. `Expect\\(n\\)\\.To\\(Equal\\(5\\)\\)`$"
+		Expect(strconv.Itoa(n)).ToNot(Equal("-3")) // want "ginkgo-linter: asserting the string conversion of n, by strconv\\.Itoa; assert the numeric value itself, instead; e\\.g\\. `Expect\\(n\\)\\.ToNot\\(Equal\\(-3\\)\\)`$"
+
+		var i int64 = 255
+		Expect(strconv.FormatInt(i, 10)).To(Equal("255"))      // want "ginkgo-linter: asserting the string conversion of i, by strconv\\.FormatInt; assert the numeric value itself, instead; e\\.g\\. `Expect\\(i\\)\\.To\\(BeNumerically\\(\"==\", 255\\)\\)`$"
+		Expect(strconv.FormatInt(i, 16)).To(Equal("ff"))       // want "ginkgo-linter: asserting the string conversion of i, by strconv\\.FormatInt; assert the numeric value itself, instead; e\\.g\\. `Expect\\(i\\)\\.To\\(BeNumerically\\(\"==\", 255\\)\\)`$"
+		Expect(strconv.FormatInt(int64(n), 10)).To(Equal("5")) // want "ginkgo-linter: asserting the string conversion of int64\\(n\\), by strconv\\.FormatInt"
+	})
+
+	It("should not trigger a warning", func() {
+		n := 5
+		s := "5"
+		base := 10
+
+		Expect(strconv.Itoa(n)).To(Equal(s))
+		Expect(strconv.Itoa(n)).To(Equal("05"))
+		Expect(strconv.Itoa(n)).To(Equal("five"))
+		Expect(strconv.Itoa(n)).To(HavePrefix("5"))
+		Expect(strconv.FormatInt(int64(n), base)).To(Equal("5"))
+		Expect(strconv.FormatInt(int64(n), 16)).To(Equal("FF"))
+		Expect(strconv.Quote("a")).To(Equal(`"a"`))
+		Expect(n).To(Equal(5))
+	})
+})
@@ -0,0 +1,42 @@
+package stringindex
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type name string
+
+const prefix = "ab"
+
+var _ = Describe("string index compared with a string", func() {
+	It("should trigger a warning", func() {
+		s := "abc"
+		Expect(s[0]).To(Equal("a"))    // want `ginkgo-linter: comparing a string index with a string always fails, because the index of a string is a byte\. Consider using .Expect\(s\[0\]\)\.To\(Equal\(byte\('a'\)\)\). instead`
+		Expect(s[1]).ToNot(Equal("c")) // want `ginkgo-linter: comparing a string index with a string always fails, because the index of a string is a byte\. Consider using .Expect\(s\[1\]\)\.ToNot\(Equal\(byte\('c'\)\)\). instead`
+		Expect(s[2]).To(Equal("\n"))   // want `ginkgo-linter: comparing a string index with a string always fails, because the index of a string is a byte\. Consider using .Expect\(s\[2\]\)\.To\(Equal\(byte\('\\n'\)\)\). instead`
+
+		n := name("abc")
+		Ω(n[0]).Should(Equal("a")) // want `ginkgo-linter: comparing a string index with a string always fails, because the index of a string is a byte\. Consider using .Ω\(n\[0\]\)\.Should\(Equal\(byte\('a'\)\)\). instead`
+
+		Expect(s[0]).To(Equal(prefix)) // want `ginkgo-linter: comparing a string index with a string always fails, because the index of a string is a byte; compare it with a byte, or use the ContainSubstring or the HavePrefix matchers with the string`
+		other := "a"
+		Expect(s[0]).To(Equal(other)) // want `ginkgo-linter: comparing a string index with a string always fails, because the index of a string is a byte; compare it with a byte, or use the ContainSubstring or the HavePrefix matchers with the string`
+	})
+
+	It("should not trigger a warning", func() {
+		s := "abc"
+		Expect(s[0]).To(Equal(byte('a')))
+		Expect(s[:1]).To(Equal("a"))
+		Expect(s).To(HavePrefix("a"))
+
+		strs := []string{"a", "b"}
+		Expect(strs[0]).To(Equal("a"))
+
+		m := map[string]string{"a": "b"}
+		Expect(m["a"]).To(Equal("b"))
+
+		// ginkgo-linter:ignore-type-compare-warning
+		Expect(s[0]).To(Equal("a"))
+	})
+})
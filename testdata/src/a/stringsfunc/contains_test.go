@@ -0,0 +1,27 @@
+package stringsfunc
+
+import (
+	. "strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("strings.Contains assertions", func() {
+	It("should trigger a warning", func() {
+		out := "an error occurred"
+		sub := "error"
+		Expect(Contains(out, "error")).To(BeTrue())   // want "ginkgo-linter: wrong strings\\.Contains assertion; use the ContainSubstring matcher instead, for a better failure message\\. Consider using `Expect\\(out\\)\\.To\\(ContainSubstring\\(\"error\"\\)\\)` instead"
+		Expect(Contains(out, sub)).To(BeFalse())      // want "ginkgo-linter: wrong strings\\.Contains assertion; use the ContainSubstring matcher instead, for a better failure message\\. Consider using `Expect\\(out\\)\\.ToNot\\(ContainSubstring\\(sub\\)\\)` instead"
+		Ω(Contains(out, sub+"!")).ShouldNot(BeTrue()) // want "ginkgo-linter: wrong strings\\.Contains assertion; use the ContainSubstring matcher instead, for a better failure message\\. Consider using `Ω\\(out\\)\\.ShouldNot\\(ContainSubstring\\(sub \\+ \"!\"\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		out := "an error occurred"
+		Expect(out).To(ContainSubstring("error"))
+		Expect(ContainsAny(out, "xyz")).To(BeFalse())
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(Contains(out, "error")).To(BeTrue())
+	})
+})
@@ -0,0 +1,37 @@
+package stringsfunc
+
+import (
+	"bytes"
+	"strings"
+	str "strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func hasPrefix(s, prefix string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+var _ = Describe("strings.HasPrefix and strings.HasSuffix assertions", func() {
+	It("should trigger a warning", func() {
+		s := "foobar"
+		Expect(strings.HasPrefix(s, "foo")).To(BeTrue())        // want "ginkgo-linter: wrong strings\\.HasPrefix assertion; use the HavePrefix matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.To\\(HavePrefix\\(\"foo\"\\)\\)` instead"
+		Expect(strings.HasPrefix(s, "bar")).To(BeFalse())       // want "ginkgo-linter: wrong strings\\.HasPrefix assertion; use the HavePrefix matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.ToNot\\(HavePrefix\\(\"bar\"\\)\\)` instead"
+		Expect(strings.HasSuffix(s, "bar")).Should(Equal(true)) // want "ginkgo-linter: wrong strings\\.HasSuffix assertion; use the HaveSuffix matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.Should\\(HaveSuffix\\(\"bar\"\\)\\)` instead"
+		Ω(str.HasSuffix(s, "foo")).ShouldNot(BeTrue())          // want "ginkgo-linter: wrong strings\\.HasSuffix assertion; use the HaveSuffix matcher instead, for a better failure message\\. Consider using `Ω\\(s\\)\\.ShouldNot\\(HaveSuffix\\(\"foo\"\\)\\)` instead"
+		Expect(strings.HasPrefix(s, "foo")).ToNot(BeFalse())    // want "ginkgo-linter: wrong strings\\.HasPrefix assertion; use the HavePrefix matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.To\\(HavePrefix\\(\"foo\"\\)\\)` instead"
+		Expect(str.Contains(s, "oba")).To(BeTrue())             // want "ginkgo-linter: wrong strings\\.Contains assertion; use the ContainSubstring matcher instead, for a better failure message\\. Consider using `Expect\\(s\\)\\.To\\(ContainSubstring\\(\"oba\"\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		s := "foobar"
+		Expect(s).To(HavePrefix("foo"))
+		Expect(s).ToNot(HaveSuffix("foo"))
+		Expect(hasPrefix(s, "foo")).To(BeTrue())
+		Expect(bytes.HasPrefix([]byte(s), []byte("foo"))).To(BeTrue())
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(strings.HasPrefix(s, "foo")).To(BeTrue())
+	})
+})
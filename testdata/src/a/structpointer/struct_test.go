@@ -0,0 +1,43 @@
+package structpointer
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type withPtr struct {
+	name  string
+	value *int
+}
+
+type outer struct {
+	id    int
+	inner withPtr
+}
+
+type plain struct {
+	name string
+	ids  []int
+}
+
+var _ = Describe("struct with pointer fields", func() {
+	It("should trigger a warning", func() {
+		v1, v2 := 1, 1
+		a := withPtr{name: "a", value: &v1}
+		b := withPtr{name: "a", value: &v2}
+		Expect(a).To(Equal(b)) // want `ginkgo-linter: comparing a struct with a pointer field \(value\), using Equal; Equal compares the pointed values, and not the pointers themselves, so different pointers to equal values are considered as equal`
+
+		o := outer{id: 1, inner: a}
+		Ω(o).ShouldNot(Equal(outer{})) // want `ginkgo-linter: comparing a struct with a pointer field \(inner\.value\), using Equal`
+	})
+
+	It("should not trigger a warning", func() {
+		p := plain{name: "a"}
+		Expect(p).To(Equal(plain{name: "a"}))
+
+		v := 1
+		a := withPtr{name: "a", value: &v}
+		Expect(a.name).To(Equal("a"))
+		Expect(a.value).To(BeIdenticalTo(&v))
+	})
+})
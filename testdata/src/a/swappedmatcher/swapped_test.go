@@ -0,0 +1,34 @@
+package swappedmatcher
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+var _ = Describe("swapped actual value and matcher", func() {
+	It("should trigger a warning", func() {
+		s := []int{1, 2, 3}
+
+		Expect(HaveLen(3)).To(Equal(s))                  // want "ginkgo-linter: the actual value and the matcher are swapped; HaveLen\\(3\\) is a gomega matcher, and should be passed to the assertion method\\. Consider using `Expect\\(s\\)\\.To\\(HaveLen\\(3\\)\\)` instead$"
+		Expect(ContainElement(2)).ToNot(Equal(s))        // want "ginkgo-linter: the actual value and the matcher are swapped; ContainElement\\(2\\) is a gomega matcher, and should be passed to the assertion method\\. Consider using `Expect\\(s\\)\\.ToNot\\(ContainElement\\(2\\)\\)` instead$"
+		Expect(HavePrefix("a")).To(BeEquivalentTo("ab")) // want "ginkgo-linter: the actual value and the matcher are swapped; HavePrefix\\(\"a\"\\) is a gomega matcher, and should be passed to the assertion method\\. Consider using `Expect\\(\"ab\"\\)\\.To\\(HavePrefix\\(\"a\"\\)\\)` instead$"
+		Ω(HaveLen(3)).Should(Not(BeIdenticalTo(s)))      // want "ginkgo-linter: the actual value and the matcher are swapped; HaveLen\\(3\\) is a gomega matcher, and should be passed to the assertion method\\. Consider using `Ω\\(s\\)\\.ShouldNot\\(HaveLen\\(3\\)\\)` instead$"
+
+		var m types.GomegaMatcher = HaveLen(3)
+		Expect(m).To(Equal(s)) // want "ginkgo-linter: the actual value and the matcher are swapped; m is a gomega matcher, and should be passed to the assertion method$"
+	})
+
+	It("should not trigger a warning", func() {
+		s := []int{1, 2, 3}
+		m := HaveLen(3)
+
+		Expect(s).To(HaveLen(3))
+		Expect(m).To(Equal(HaveLen(3)))
+		Expect(m).ToNot(BeNil())
+		Eventually(m).Should(Equal(m))
+
+		// ginkgo-linter:ignore-type-compare-warning
+		Expect(HaveLen(3)).To(Equal(s))
+	})
+})
@@ -0,0 +1,40 @@
+package synccopy
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type counter struct {
+	lock  sync.Mutex
+	count int
+}
+
+type guarded struct {
+	inner counter
+}
+
+type byRef struct {
+	lock  *sync.RWMutex
+	count int
+}
+
+var _ = Describe("comparing sync types", func() {
+	It("should trigger a warning", func() {
+		var wg sync.WaitGroup
+		Expect(wg).To(Equal(sync.WaitGroup{}))                          // want `ginkgo-linter: use Equal with a value that contains a sync\.WaitGroup; the comparison copies the sync\.WaitGroup, and compares its internal state\. Assert on the data it guards instead`
+		Expect(counter{count: 1}).To(BeEquivalentTo(counter{count: 1})) // want `ginkgo-linter: use BeEquivalentTo with a value that contains a sync\.Mutex; the comparison copies the sync\.Mutex, and compares its internal state\. Assert on the data it guards instead`
+		Expect(guarded{}).ToNot(BeIdenticalTo(guarded{}))               // want `ginkgo-linter: use BeIdenticalTo with a value that contains a sync\.Mutex; the comparison copies the sync\.Mutex, and compares its internal state\. Assert on the data it guards instead`
+		Expect([2]sync.Once{}).To(Equal([2]sync.Once{}))                // want `ginkgo-linter: use Equal with a value that contains a sync\.Once; the comparison copies the sync\.Once, and compares its internal state\. Assert on the data it guards instead`
+	})
+
+	It("should not trigger a warning", func() {
+		c := &counter{count: 1}
+		Expect(c.count).To(Equal(1))
+		Expect(c).To(Equal(&counter{count: 1}))
+		Expect(byRef{count: 1}).To(Equal(byRef{count: 1}))
+		Expect([]sync.Mutex{}).To(BeEmpty())
+	})
+})
@@ -0,0 +1,42 @@
+package syncmapload
+
+import (
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type store struct {
+	m sync.Map
+}
+
+type fakeMap struct{}
+
+func (fakeMap) Load(key any) (any, bool) {
+	return nil, false
+}
+
+var _ = Describe("sync.Map results", func() {
+	It("should trigger a warning", func() {
+		var m sync.Map
+		m.Store("a", 1)
+		Expect(m.Load("a")).To(Equal(1))           // want `ginkgo-linter: sync\.Map\.Load returns a value and a boolean; gomega requires the extra values to be zero, so this assertion fails whenever the key is found; assign the results to variables, and assert them separately`
+		Expect(m.LoadOrStore("b", 2)).To(Equal(2)) // want `ginkgo-linter: sync\.Map\.LoadOrStore returns a value and a boolean; gomega requires the extra values to be zero, so this assertion fails whenever the key is found; assign the results to variables, and assert them separately`
+		Ω(m.LoadAndDelete("a")).Should(Equal(1))   // want `ginkgo-linter: sync\.Map\.LoadAndDelete returns a value and a boolean; gomega requires the extra values to be zero, so this assertion fails whenever the key is found; assign the results to variables, and assert them separately`
+
+		s := &store{}
+		Expect(s.m.Load("a")).To(BeNil()) // want `ginkgo-linter: sync\.Map\.Load returns a value and a boolean; gomega requires the extra values to be zero, so this assertion fails whenever the key is found; assign the results to variables, and assert them separately`
+	})
+
+	It("should not trigger a warning", func() {
+		var m sync.Map
+		m.Store("a", 1)
+		v, ok := m.Load("a")
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal(1))
+
+		f := fakeMap{}
+		Expect(f.Load("a")).To(BeNil())
+	})
+})
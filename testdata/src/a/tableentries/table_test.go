@@ -0,0 +1,71 @@
+package tableentries
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/ginkgo/v2/types"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("table entries", func() {
+	DescribeTable("two parameters", func(a int, b string) {
+		Expect(a).To(BeNumerically(">", 0))
+		Expect(b).ToNot(BeEmpty())
+	},
+		Entry("ok", 1, "a"),
+		Entry("ok with decorators", Label("x"), 1, "a"),
+		Entry("too few", 1),                                 // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 2 parameters, but the entry passes 1; this will panic in runtime`
+		FEntry("too many", 1, "a", 3),                       // want `ginkgo-linter: wrong number of parameters in FEntry: the table function receives 2 parameters, but the entry passes 3; this will panic in runtime`
+		PEntry("too few, with decorators", Label("x"), "a"), // want `ginkgo-linter: wrong number of parameters in PEntry: the table function receives 2 parameters, but the entry passes 1; this will panic in runtime`
+	)
+
+	DescribeTable("variadic", func(a int, rest ...string) {
+		Expect(a).To(BeNumerically(">", len(rest)))
+	},
+		Entry("ok", 1),
+		Entry("ok with variadic params", 3, "a", "b"),
+		Entry("too few"), // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives at least 1 parameter, but the entry passes 0; this will panic in runtime`
+	)
+
+	DescribeTable("one parameter", func(a int) {
+		Expect(a).To(Equal(1))
+	},
+		Entry("ok", 1),
+		Entry("too many", 1, 2), // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 1 parameter, but the entry passes 2; this will panic in runtime`
+	)
+
+	DescribeTable("function typed decorator", types.LabelFilter(nil), func(a, b int) {
+		Expect(a).To(Equal(b))
+	},
+		Entry("ok", 1, 1),
+		Entry("too few", 1), // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 2 parameters, but the entry passes 1; this will panic in runtime`
+	)
+
+	DescribeTable("context", func(ctx context.Context, a int) {
+		Expect(ctx).ToNot(BeNil())
+		Expect(a).To(Equal(1))
+	},
+		Entry("ok", 1),
+		Entry("too many", 1, 2, 3), // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 2 parameters, but the entry passes 3; this will panic in runtime`
+	)
+
+	DescribeTable("spec context", func(ctx SpecContext, a int) {
+		Expect(ctx).ToNot(BeNil())
+		Expect(a).To(Equal(1))
+	},
+		Entry("ok", 1),
+		Entry("too many", 1, 2, 3), // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 2 parameters, but the entry passes 3; this will panic in runtime`
+	)
+})
+
+var _ = DescribeTable("top level table", func(a, b int) {
+	Expect(a).To(Equal(b))
+},
+	Entry("ok", 1, 1),
+	Entry(nil, 1, 1),
+	Entry(func(a, b int) string { return "generated" }, 1, 1),
+	Entry(nil, 1),       // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 2 parameters, but the entry passes 1; this will panic in runtime`
+	Entry([]int{1}, 1),  // wrong description; ginkgo fails on it anyway
+	Entry("too few", 1), // want `ginkgo-linter: wrong number of parameters in Entry: the table function receives 2 parameters, but the entry passes 1; this will panic in runtime`
+)
@@ -0,0 +1,35 @@
+package testingstate
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeT struct{}
+
+func (fakeT) Failed() bool { return false }
+
+func checkT(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(t.Failed()).To(BeFalse()) // want `ginkgo-linter: asserting the test state with Failed\(\) mixes the testing framework with gomega; gomega already fails the test when an assertion fails, so there is no need to assert the test state`
+}
+
+func checkTB(tb testing.TB) {
+	g := NewWithT(tb)
+	g.Expect(tb.Skipped()).To(BeFalse()) // want `ginkgo-linter: asserting the test state with Skipped\(\) mixes the testing framework with gomega; gomega already fails the test when an assertion fails, so there is no need to assert the test state`
+}
+
+var _ = Describe("testing state", func() {
+	It("should trigger a warning", func() {
+		Expect(GinkgoT().Failed()).To(BeFalse()) // want `ginkgo-linter: asserting the test state with Failed\(\) mixes the testing framework with gomega; gomega already fails the test when an assertion fails, so there is no need to assert the test state`
+		t := GinkgoT()
+		Expect(t.Skipped()).To(Equal(false)) // want `ginkgo-linter: asserting the test state with Skipped\(\) mixes the testing framework with gomega; gomega already fails the test when an assertion fails, so there is no need to assert the test state`
+	})
+
+	It("should not trigger a warning", func() {
+		Expect(fakeT{}.Failed()).To(BeFalse())
+		Expect(GinkgoT().Name()).ToNot(BeEmpty())
+	})
+})
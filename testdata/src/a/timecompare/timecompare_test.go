@@ -0,0 +1,44 @@
+package timecompare
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type event struct {
+	at time.Time
+}
+
+func (event) After(time.Time) bool {
+	return false
+}
+
+var _ = Describe("time.Time comparison method assertions", func() {
+	It("should trigger a warning", func() {
+		t1 := time.Now()
+		t2 := t1.Add(time.Second)
+		e := event{at: t1}
+
+		Expect(t2.After(t1)).To(BeTrue())                // want "ginkgo-linter: wrong time\\.Time\\.After assertion; use the BeTemporally matcher instead, for a better failure message\\. Consider using `Expect\\(t2\\)\\.To\\(BeTemporally\\(\">\", t1\\)\\)` instead"
+		Expect(t1.Before(t2)).Should(Equal(true))        // want "ginkgo-linter: wrong time\\.Time\\.Before assertion; use the BeTemporally matcher instead, for a better failure message\\. Consider using `Expect\\(t1\\)\\.Should\\(BeTemporally\\(\"<\", t2\\)\\)` instead"
+		Expect(t1.Equal(e.at)).To(BeTrue())              // want "ginkgo-linter: wrong time\\.Time\\.Equal assertion; use the BeTemporally matcher instead, for a better failure message\\. Consider using `Expect\\(t1\\)\\.To\\(BeTemporally\\(\"==\", e\\.at\\)\\)` instead"
+		Expect(t1.After(t2)).To(BeFalse())               // want "ginkgo-linter: wrong time\\.Time\\.After assertion; use the BeTemporally matcher instead, for a better failure message\\. Consider using `Expect\\(t1\\)\\.ToNot\\(BeTemporally\\(\">\", t2\\)\\)` instead"
+		Ω(e.at.Before(t2)).ShouldNot(BeFalse())          // want "ginkgo-linter: wrong time\\.Time\\.Before assertion; use the BeTemporally matcher instead, for a better failure message\\. Consider using `Ω\\(e\\.at\\)\\.Should\\(BeTemporally\\(\"<\", t2\\)\\)` instead"
+		Expect(t1.Add(time.Hour).After(t2)).To(BeTrue()) // want "ginkgo-linter: wrong time\\.Time\\.After assertion; use the BeTemporally matcher instead, for a better failure message\\. Consider using `Expect\\(t1\\.Add\\(time\\.Hour\\)\\)\\.To\\(BeTemporally\\(\">\", t2\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		t1 := time.Now()
+		t2 := t1.Add(time.Second)
+		e := event{at: t1}
+
+		Expect(t2).To(BeTemporally(">", t1))
+		Expect(e.After(t1)).To(BeFalse())
+		Expect(t1.IsZero()).To(BeFalse())
+
+		// ginkgo-linter:ignore-compare-assert-warning
+		Expect(t2.After(t1)).To(BeTrue())
+	})
+})
@@ -0,0 +1,27 @@
+package timenowequal
+
+import (
+	"time"
+	gotime "time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Equal assertions of time.Now()", func() {
+	It("should trigger a warning", func() {
+		expected := time.Now()
+		Expect(time.Now()).To(Equal(expected))              // want "ginkgo-linter: comparing time\\.Now\\(\\) using Equal; the current time is never equal to a time value that was taken before, so this assertion always fails; use the BeTemporally matcher instead; e\\.g\\. `BeTemporally\\(\"~\", expected, time\\.Second\\)`$"
+		Expect(time.Now()).ToNot(Equal(expected))           // want `ginkgo-linter: comparing time\.Now\(\) using Equal; the current time is never equal to a time value that was taken before, so this assertion always passes`
+		Expect(time.Now()).To(Not(BeIdenticalTo(expected))) // want `ginkgo-linter: comparing time\.Now\(\) using BeIdenticalTo; the current time is never equal to a time value that was taken before, so this assertion always passes`
+		Ω(gotime.Now()).Should(Equal(expected))             // want `ginkgo-linter: comparing time\.Now\(\) using Equal; the current time is never equal to a time value that was taken before, so this assertion always fails`
+	})
+
+	It("should not trigger a warning", func() {
+		expected := time.Now()
+		Expect(time.Now()).To(BeTemporally("~", expected, time.Second))
+		Expect(time.Now()).To(BeTemporally(">=", expected))
+		Expect(expected).To(Equal(expected))
+		Expect(time.Now().Year()).To(Equal(expected.Year()))
+	})
+})
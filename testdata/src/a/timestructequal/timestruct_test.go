@@ -0,0 +1,46 @@
+package timestructequal
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type event struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+type meta struct {
+	UpdatedAt *time.Time
+}
+
+type resource struct {
+	Name string
+	Meta meta
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+var _ = Describe("Equal assertions of structs with time.Time fields", func() {
+	It("should trigger a warning", func() {
+		now := time.Now()
+		got := event{Name: "a", CreatedAt: now}
+		Expect(got).To(Equal(event{Name: "a", CreatedAt: now}))        // want `ginkgo-linter: comparing a struct with a time\.Time field \(CreatedAt\) using Equal; two time values of the same instant may have a different location or monotonic clock reading, so this assertion may fail; consider using BeComparableTo, that compares time values with their Equal method, or the gstruct matchers`
+		Expect(&got).ToNot(Equal(&event{Name: "b"}))                   // want `ginkgo-linter: comparing a struct with a time\.Time field \(CreatedAt\) using Equal`
+		Expect(resource{Name: "r"}).Should(Equal(resource{Name: "r"})) // want `ginkgo-linter: comparing a struct with a time\.Time field \(Meta\.UpdatedAt\) using Equal`
+	})
+
+	It("should not trigger a warning", func() {
+		now := time.Now()
+		got := event{Name: "a", CreatedAt: now}
+		Expect(got).To(BeComparableTo(event{Name: "a", CreatedAt: now}))
+		Expect(got.Name).To(Equal("a"))
+		Expect(got.CreatedAt).To(BeTemporally("==", now))
+		Expect(node{Value: 1}).To(Equal(node{Value: 1}))
+	})
+})
@@ -35,6 +35,18 @@ var _ = Describe("check async intervals", func() {
 		Eventually(func() bool { return true }, polling*1000).ProbeEvery(timeout + 10000000000000).Should(BeTrue())  // want `timeout must not be shorter than the polling interval`
 	})
 
+	It("polling method before the timeout method", func() {
+		Eventually(func() bool { return true }).WithPolling(time.Second * (10 + factor)).WithTimeout(time.Second * 10).Should(BeTrue()) // want `timeout must not be shorter than the polling interval`
+		Eventually(func() bool { return true }).ProbeEvery(time.Second * (10 + factor)).Within(time.Second * 10).Should(BeTrue())       // want `timeout must not be shorter than the polling interval`
+		Eventually(func() bool { return true }).WithPolling(polling).WithTimeout(timeout).Should(BeTrue())                              // valid
+	})
+
+	It("non-constant intervals", func() {
+		dynamicTimeout := time.Duration(factor) * time.Millisecond
+		Eventually(func() bool { return true }).WithTimeout(dynamicTimeout).WithPolling(time.Second).Should(BeTrue())
+		Eventually(func() bool { return true }, dynamicTimeout, time.Second).Should(BeTrue())
+	})
+
 	It("Consistently timeout shorter than polling", func() {
 		Consistently(func() bool { return true }, timeout, pkg.Timeout).Should(BeTrue())                                                  // valid
 		Consistently(func() bool { return true }).WithTimeout(time.Second * 10).WithPolling(time.Second * (10 + factor)).Should(BeTrue()) // want `timeout must not be shorter than the polling interval`
@@ -0,0 +1,32 @@
+package tupleerror
+
+import (
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getPair() (int, bool) {
+	return 1, true
+}
+
+var _ = Describe("implicit error check of multiple results", func() {
+	It("should trigger a warning", func() {
+		Expect(strconv.Atoi("5")).To(Equal(5))                  // want "ginkgo-linter: strconv\\.Atoi\\(\"5\"\\) returns a value and an error, and the error is only implicitly asserted to be nil; assign the results to variables, and assert the error explicitly; e\\.g\\. `Expect\\(err\\)\\.ToNot\\(HaveOccurred\\(\\)\\)`"
+		Ω(strconv.ParseBool("true")).Should(BeTrue())           // want `ginkgo-linter: strconv\.ParseBool\("true"\) returns a value and an error, and the error is only implicitly asserted to be nil`
+		Expect(strconv.Atoi("5")).ToNot(BeNumerically(">", 10)) // want `ginkgo-linter: strconv\.Atoi\("5"\) returns a value and an error, and the error is only implicitly asserted to be nil`
+	})
+
+	It("should not trigger a warning", func() {
+		n, err := strconv.Atoi("5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(5))
+
+		Expect(getPair()).To(Equal(1))
+
+		Eventually(func() (int, error) {
+			return strconv.Atoi("5")
+		}).Should(Equal(5))
+	})
+})
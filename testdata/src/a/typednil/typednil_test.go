@@ -0,0 +1,56 @@
+package typednil
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type myErr struct{}
+
+func (*myErr) Error() string {
+	return "my error"
+}
+
+func getMyErr() *myErr {
+	return nil
+}
+
+func getErr() error {
+	return nil
+}
+
+var _ = Describe("typed nil interface", func() {
+	It("should trigger a warning", func() {
+		var p *myErr
+		var err error = p
+		Expect(err).ToNot(HaveOccurred()) // want "ginkgo-linter: err is an interface, that is assigned with a value of the \\*myErr type; HaveOccurred\\(\\) treats err as nil when it holds a nil \\*myErr, but err itself is not nil, and `err == nil` is false; assert the concrete value instead"
+
+		err2 := error(getMyErr())
+		Expect(err2).To(HaveOccurred()) // want "ginkgo-linter: err2 is an interface, that is assigned with a value of the \\*myErr type; HaveOccurred\\(\\) treats err2 as nil"
+
+		var v any
+		v = getMyErr()
+		Ω(v).Should(BeNil()) // want "ginkgo-linter: v is an interface, that is assigned with a value of the \\*myErr type; BeNil\\(\\) treats v as nil when it holds a nil \\*myErr, but v itself is not nil, and `v == nil` is false; assert the concrete value instead"
+	})
+
+	It("should not trigger a warning", func() {
+		var p *myErr
+		Expect(p).ToNot(HaveOccurred())
+
+		err := getErr()
+		Expect(err).ToNot(HaveOccurred())
+
+		var err2 error = p
+		err2 = getErr()
+		Expect(err2).ToNot(HaveOccurred())
+
+		var v any = &myErr{}
+		Expect(v).ToNot(BeNil())
+
+		var v2 any = new(myErr)
+		Expect(v2).ToNot(BeNil())
+
+		var v3 any = p
+		Expect(v3).To(Equal(p))
+	})
+})
@@ -0,0 +1,55 @@
+package unguardedderef
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type holder struct {
+	p *int
+}
+
+func getPtr() *int {
+	v := 5
+	return &v
+}
+
+var _ = Describe("unguarded pointer dereference", func() {
+	It("should trigger a warning", func() {
+		p := getPtr()
+		Expect(*p).To(Equal(5)) // want "ginkgo-linter: asserting \\*p panics if p is nil, before gomega checks anything; assert that p is not nil first, or use the HaveValue matcher \\(or gstruct's PointTo\\); e\\.g\\. `Expect\\(p\\)\\.ToNot\\(BeNil\\(\\)\\)`"
+
+		h := holder{p: getPtr()}
+		Expect(*h.p).To(Equal(5)) // want "ginkgo-linter: asserting \\*h\\.p panics if h\\.p is nil, before gomega checks anything; assert that h\\.p is not nil first, or use the HaveValue matcher \\(or gstruct's PointTo\\); e\\.g\\. `Expect\\(h\\.p\\)\\.ToNot\\(BeNil\\(\\)\\)`"
+
+		p2 := getPtr()
+		Expect(p2).To(BeNil())
+		Expect(*p2).To(Equal(5)) // want "ginkgo-linter: asserting \\*p2 panics if p2 is nil, before gomega checks anything; assert that p2 is not nil first, or use the HaveValue matcher \\(or gstruct's PointTo\\); e\\.g\\. `Expect\\(p2\\)\\.ToNot\\(BeNil\\(\\)\\)`"
+
+		v := 5
+		p3 := &v
+		p3 = getPtr()
+		Expect(*p3).To(Equal(5)) // want "ginkgo-linter: asserting \\*p3 panics if p3 is nil, before gomega checks anything; assert that p3 is not nil first, or use the HaveValue matcher \\(or gstruct's PointTo\\); e\\.g\\. `Expect\\(p3\\)\\.ToNot\\(BeNil\\(\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		p := getPtr()
+		Expect(p).ToNot(BeNil())
+		Expect(*p).To(Equal(5))
+
+		p2 := getPtr()
+		Expect(p2).To(Not(BeNil()))
+		Expect(*p2).To(Equal(5))
+
+		p3 := getPtr()
+		Expect(p3).To(HaveValue(Equal(5)))
+		Expect(*p3).To(Equal(5))
+
+		v := 5
+		p4 := &v
+		Expect(*p4).To(Equal(5))
+
+		p5 := new(int)
+		Expect(*p5).To(BeZero())
+	})
+})
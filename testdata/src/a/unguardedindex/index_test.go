@@ -0,0 +1,78 @@
+package unguardedindex
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type holder struct {
+	items []string
+}
+
+func getItems() []string {
+	return []string{"a", "b"}
+}
+
+var _ = Describe("unguarded slice index", func() {
+	It("should trigger a warning", func() {
+		s := getItems()
+		Expect(s[0]).To(Equal("a")) // want "ginkgo-linter: asserting s\\[0\\] panics if s is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(s\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(s\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+
+		h := holder{items: getItems()}
+		Expect(h.items[1]).To(Equal("b")) // want "ginkgo-linter: asserting h\\.items\\[1\\] panics if h\\.items is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(h\\.items\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(h\\.items\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+
+		other := getItems()
+		Expect(s).To(HaveLen(2))
+		Expect(other[0]).To(Equal("a")) // want "ginkgo-linter: asserting other\\[0\\] panics if other is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(other\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(other\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+	})
+
+	It("should trigger a warning if the previous assertion does not guard the length", func() {
+		s1 := getItems()
+		Expect(s1).To(BeEmpty())
+		Expect(s1[0]).To(Equal("a")) // want "ginkgo-linter: asserting s1\\[0\\] panics if s1 is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(s1\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(s1\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+
+		s2 := getItems()
+		Expect(s2).ToNot(HaveLen(1))
+		Expect(s2[0]).To(Equal("a")) // want "ginkgo-linter: asserting s2\\[0\\] panics if s2 is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(s2\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(s2\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+
+		s3 := getItems()
+		Expect(s3).ToNot(Equal([]string{"a"}))
+		Expect(s3[0]).To(Equal("a")) // want "ginkgo-linter: asserting s3\\[0\\] panics if s3 is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(s3\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(s3\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+
+		s4 := getItems()
+		Expect(s4).To(ContainElement("a"))
+		Expect(s4[1]).To(Equal("b")) // want "ginkgo-linter: asserting s4\\[1\\] panics if s4 is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(s4\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(s4\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+
+		s5 := getItems()
+		Expect(s5).To(Not(ConsistOf("a", "b")))
+		Expect(s5[1]).To(Equal("b")) // want "ginkgo-linter: asserting s5\\[1\\] panics if s5 is too short, before gomega checks anything; assert the length first; e\\.g\\. `Expect\\(s5\\)\\.To\\(HaveLen\\(n\\)\\)` or `Expect\\(s5\\)\\.ToNot\\(BeEmpty\\(\\)\\)`"
+	})
+
+	It("should not trigger a warning", func() {
+		s := getItems()
+		Expect(s).To(HaveLen(2))
+		Expect(s[0]).To(Equal("a"))
+		Expect(s[1]).To(Equal("b"))
+
+		s2 := getItems()
+		Expect(s2).ToNot(BeEmpty())
+		Expect(s2[0]).To(Equal("a"))
+
+		s4 := getItems()
+		Expect(s4).To(Not(BeEmpty()))
+		Expect(s4[0]).To(Equal("a"))
+
+		s3 := getItems()
+		Expect(len(s3) > 1).To(BeTrue()) // want `ginkgo-linter: wrong comparison assertion\. Consider using .Expect\(len\(s3\)\)\.To\(BeNumerically\(">", 1\)\). instead`
+		Expect(s3[1]).To(Equal("b"))
+
+		arr := [2]string{"a", "b"}
+		Expect(arr[0]).To(Equal("a"))
+
+		m := map[int]string{0: "a"}
+		Expect(m[0]).To(Equal("a"))
+
+		i := 0
+		Expect(s[i]).To(Equal("a"))
+	})
+})
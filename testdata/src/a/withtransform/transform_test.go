@@ -0,0 +1,46 @@
+package withtransform
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func double(n int) int {
+	return n * 2
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+type named struct{ name string }
+
+func (n named) upper() string {
+	return strings.ToUpper(n.name)
+}
+
+var _ = Describe("transformed actual value", func() {
+	It("should trigger a warning", func() {
+		s := "ABC"
+		Expect(strings.ToLower(s)).To(Equal("abc"))       // want "ginkgo-linter: the actual value is transformed by strings\\.ToLower before the assertion, so the original value is missing from the failure message; use the WithTransform matcher instead\\. Consider using `Expect\\(s\\)\\.To\\(WithTransform\\(strings\\.ToLower, Equal\\(\"abc\"\\)\\)\\)` instead"
+		Expect(strings.TrimSpace(s)).ToNot(BeEmpty())     // want "ginkgo-linter: the actual value is transformed by strings\\.TrimSpace before the assertion, so the original value is missing from the failure message; use the WithTransform matcher instead\\. Consider using `Expect\\(s\\)\\.ToNot\\(WithTransform\\(strings\\.TrimSpace, BeEmpty\\(\\)\\)\\)` instead"
+		Expect(filepath.Base("/a/b")).To(Not(Equal("a"))) // want "ginkgo-linter: the actual value is transformed by filepath\\.Base before the assertion, so the original value is missing from the failure message; use the WithTransform matcher instead\\. Consider using `Expect\\(\"/a/b\"\\)\\.ToNot\\(WithTransform\\(filepath\\.Base, Equal\\(\"a\"\\)\\)\\)` instead"
+		Expect(double(2)).To(BeNumerically(">", 3))       // want "ginkgo-linter: the actual value is transformed by double before the assertion, so the original value is missing from the failure message; use the WithTransform matcher instead\\. Consider using `Expect\\(2\\)\\.To\\(WithTransform\\(double, BeNumerically\\(\">\", 3\\)\\)\\)` instead"
+	})
+
+	It("should not trigger a warning", func() {
+		s := "abc"
+		Expect(s).To(WithTransform(strings.ToLower, Equal("abc")))
+		Expect(strings.Repeat(s, 2)).To(Equal("abcabc"))
+		Expect(strings.HasPrefix(s, "a")).To(BeTrue()) // want "ginkgo-linter: wrong strings\\.HasPrefix assertion"
+		Expect(strconv.Atoi("5")).To(Equal(5))
+		Expect(identity(s)).To(Equal("abc"))
+		Expect(named{name: s}.upper()).To(Equal("ABC"))
+		Expect(len(s)).To(BeNumerically(">", 1))
+		Eventually(double(2)).Should(Equal(4)) // want "ginkgo-linter: use a function call in Eventually"
+	})
+})
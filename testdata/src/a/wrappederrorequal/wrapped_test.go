@@ -0,0 +1,38 @@
+package wrappederrorequal
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var errInner = errors.New("inner")
+
+func doSomething() error {
+	return fmt.Errorf("wrap: %w", errInner)
+}
+
+var _ = Describe("Equal of an error with a wrapped error", func() {
+	It("should trigger a warning", func() {
+		err := doSomething()
+		Expect(err).To(Equal(fmt.Errorf("wrap: %w", errInner)))                     // want `ginkgo-linter: comparing an error with a new wrapped error is fragile, because Equal compares the internal structure of the errors; use MatchError with the wrapped error instead\. Consider using .Expect\(err\)\.To\(MatchError\(errInner\)\). instead`
+		Expect(err).ToNot(Equal(fmt.Errorf("%s: %d: %w", "op", 3, errInner)))       // want `ginkgo-linter: comparing an error with a new wrapped error is fragile, because Equal compares the internal structure of the errors; use MatchError with the wrapped error instead\. Consider using .Expect\(err\)\.ToNot\(MatchError\(errInner\)\). instead`
+		Expect(doSomething()).Should(Equal(fmt.Errorf("100%% sure: %w", errInner))) // want `ginkgo-linter: comparing an error with a new wrapped error is fragile, because Equal compares the internal structure of the errors; use MatchError with the wrapped error instead\. Consider using .Expect\(doSomething\(\)\)\.Should\(MatchError\(errInner\)\). instead`
+	})
+
+	It("should not trigger a warning", func() {
+		err := doSomething()
+		Expect(err).To(MatchError(errInner))
+		Expect(err).To(Equal(fmt.Errorf("wrap: %v", errInner)))
+		Expect(err).To(Equal(fmt.Errorf("%w and %w", errInner, errInner)))
+		Expect(err).To(Equal(errInner))
+
+		s := "wrap: x"
+		Expect(s).To(Equal(fmt.Sprintf("wrap: %s", "x")))
+
+		// ginkgo-linter:ignore-err-assert-warning
+		Expect(err).To(Equal(fmt.Errorf("wrap: %w", errInner)))
+	})
+})
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// AssertionStyleExpect is the `Expect(x).To(...)` assertion style
+	AssertionStyleExpect AssertionStyle = "gomega-expect"
+	// AssertionStyleOmega is the `Ω(x).Should(...)` assertion style
+	AssertionStyleOmega AssertionStyle = "gomega-omega"
+)
+
+// AssertionStyle is the preferred style of the synchronous assertions: either `Expect(x).To(...)`
+// or `Ω(x).Should(...)`. The empty value means that both styles are allowed.
+//
+// AssertionStyle implements the flag.Value interface.
+type AssertionStyle string
+
+func (s *AssertionStyle) String() string {
+	if s == nil {
+		return ""
+	}
+	return string(*s)
+}
+
+func (s *AssertionStyle) Set(value string) error {
+	switch style := AssertionStyle(value); style {
+	case "", AssertionStyleExpect, AssertionStyleOmega:
+		*s = style
+		return nil
+	default:
+		return fmt.Errorf("wrong assertion style %q; should be either %q or %q", value, AssertionStyleExpect, AssertionStyleOmega)
+	}
+}
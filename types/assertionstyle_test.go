@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestAssertionStyle_Set(t *testing.T) {
+	for _, val := range []string{"", "gomega-expect", "gomega-omega"} {
+		var style AssertionStyle
+		if err := style.Set(val); err != nil {
+			t.Errorf("unexpected error for %q: %v", val, err)
+		}
+
+		if style.String() != val {
+			t.Errorf("wrong string value: %q; expected %q", style.String(), val)
+		}
+	}
+}
+
+func TestAssertionStyle_SetErrors(t *testing.T) {
+	for _, val := range []string{"expect", "Ω", "gomega-should"} {
+		style := AssertionStyleExpect
+		if err := style.Set(val); err == nil {
+			t.Errorf("expected an error for %q", val)
+		}
+
+		if style != AssertionStyleExpect {
+			t.Errorf("the value should not be changed on error; got %q", style)
+		}
+	}
+}
@@ -17,18 +17,72 @@ const (
 )
 
 type Config struct {
-	SuppressLen            bool
-	SuppressNil            bool
-	SuppressErr            bool
-	SuppressCompare        bool
-	SuppressAsync          bool
-	ForbidFocus            bool
-	SuppressTypeCompare    bool
-	AllowHaveLen0          bool
-	ForceExpectTo          bool
-	ValidateAsyncIntervals bool
-	ForbidSpecPollution    bool
-	ForceSucceedForFuncs   bool
+	SuppressLen                  bool
+	SuppressNil                  bool
+	SuppressErr                  bool
+	SuppressCompare              bool
+	SuppressAsync                bool
+	ForbidFocus                  bool
+	ForbidPending                bool
+	SuppressTypeCompare          bool
+	AllowHaveLen0                bool
+	AllowHaveCap0                bool
+	ForceExpect                  bool
+	ForceExpectTo                bool
+	ValidateAsyncIntervals       bool
+	ForbidSpecPollution          bool
+	ForceSucceedForFuncs         bool
+	ForceToNot                   bool
+	ForbidBoolLiteral            bool
+	ForbidErrorsAs               bool
+	ForbidSleepBeforeAssertion   bool
+	ForbidUnguardedIndex         bool
+	ForceMatchJSON               bool
+	ForbidDiscardedError         bool
+	MatcherAliases               MatcherAliases
+	AssertionStyle               AssertionStyle
+	ForceKnownLength             bool
+	ForbidGoroutineSharedVar     bool
+	ForbidTestingStateAssertion  bool
+	ForbidUnguardedDeref         bool
+	ForbidChannelLenAssertion    bool
+	ForbidLoopVarCapture         bool
+	ForbidAssertionResult        bool
+	ForbidRedundantSucceed       bool
+	ForbidDeferRecover           bool
+	ForbidMethodExpression       bool
+	ForbidRuneCount              bool
+	ForceExactElements           bool
+	ForbidAsyncBlocking          bool
+	ForbidBufferBytes            bool
+	ForbidStructPointerEqual     bool
+	ForbidTypedNilInterface      bool
+	ForbidProtoEqual             bool
+	ForbidDeferredAssertion      bool
+	ForbidNumericBounds          bool
+	ForbidSQLNullNoValid         bool
+	ForceHaveKeyWithValue        bool
+	ForceContainElementHaveField bool
+	ForbidAsyncDiscardedError    bool
+	ForbidImplicitTupleError     bool
+	ForbidContextDoneMisuse      bool
+	ForbidLargeArrayEqual        bool
+	LargeArrayLen                int
+	ForbidFloatIntCompare        bool
+	ForceMatchErrorStringMatcher bool
+	ForbidMapOrderEqual          bool
+	ForceFileMatchers            bool
+	ForbidStrconvFormatEqual     bool
+	ForbidReversedEqual          bool
+	WarnFloatEqual               bool
+	ForceSatisfyAll              bool
+	SuppressEquivalentTo         bool
+	ForbidConsistentlyReceive    bool
+	ForbidSharedMatcher          bool
+	ForbidErrorSliceEqual        bool
+	ForceWithTransform           bool
+	ForbidExpectWithOffset       bool
+	ForbidTimeStructEqual        bool
 }
 
 func (s *Config) AllTrue() bool {
@@ -37,18 +91,72 @@ func (s *Config) AllTrue() bool {
 
 func (s *Config) Clone() Config {
 	return Config{
-		SuppressLen:            s.SuppressLen,
-		SuppressNil:            s.SuppressNil,
-		SuppressErr:            s.SuppressErr,
-		SuppressCompare:        s.SuppressCompare,
-		SuppressAsync:          s.SuppressAsync,
-		ForbidFocus:            s.ForbidFocus,
-		SuppressTypeCompare:    s.SuppressTypeCompare,
-		AllowHaveLen0:          s.AllowHaveLen0,
-		ForceExpectTo:          s.ForceExpectTo,
-		ValidateAsyncIntervals: s.ValidateAsyncIntervals,
-		ForbidSpecPollution:    s.ForbidSpecPollution,
-		ForceSucceedForFuncs:   s.ForceSucceedForFuncs,
+		SuppressLen:                  s.SuppressLen,
+		SuppressNil:                  s.SuppressNil,
+		SuppressErr:                  s.SuppressErr,
+		SuppressCompare:              s.SuppressCompare,
+		SuppressAsync:                s.SuppressAsync,
+		ForbidFocus:                  s.ForbidFocus,
+		ForbidPending:                s.ForbidPending,
+		SuppressTypeCompare:          s.SuppressTypeCompare,
+		AllowHaveLen0:                s.AllowHaveLen0,
+		AllowHaveCap0:                s.AllowHaveCap0,
+		ForceExpect:                  s.ForceExpect,
+		ForceExpectTo:                s.ForceExpectTo,
+		ValidateAsyncIntervals:       s.ValidateAsyncIntervals,
+		ForbidSpecPollution:          s.ForbidSpecPollution,
+		ForceSucceedForFuncs:         s.ForceSucceedForFuncs,
+		ForceToNot:                   s.ForceToNot,
+		ForbidBoolLiteral:            s.ForbidBoolLiteral,
+		ForbidErrorsAs:               s.ForbidErrorsAs,
+		ForbidSleepBeforeAssertion:   s.ForbidSleepBeforeAssertion,
+		ForbidUnguardedIndex:         s.ForbidUnguardedIndex,
+		ForceMatchJSON:               s.ForceMatchJSON,
+		ForbidDiscardedError:         s.ForbidDiscardedError,
+		MatcherAliases:               s.MatcherAliases,
+		AssertionStyle:               s.AssertionStyle,
+		ForceKnownLength:             s.ForceKnownLength,
+		ForbidGoroutineSharedVar:     s.ForbidGoroutineSharedVar,
+		ForbidTestingStateAssertion:  s.ForbidTestingStateAssertion,
+		ForbidUnguardedDeref:         s.ForbidUnguardedDeref,
+		ForbidChannelLenAssertion:    s.ForbidChannelLenAssertion,
+		ForbidLoopVarCapture:         s.ForbidLoopVarCapture,
+		ForbidAssertionResult:        s.ForbidAssertionResult,
+		ForbidRedundantSucceed:       s.ForbidRedundantSucceed,
+		ForbidDeferRecover:           s.ForbidDeferRecover,
+		ForbidMethodExpression:       s.ForbidMethodExpression,
+		ForbidRuneCount:              s.ForbidRuneCount,
+		ForceExactElements:           s.ForceExactElements,
+		ForbidAsyncBlocking:          s.ForbidAsyncBlocking,
+		ForbidBufferBytes:            s.ForbidBufferBytes,
+		ForbidStructPointerEqual:     s.ForbidStructPointerEqual,
+		ForbidTypedNilInterface:      s.ForbidTypedNilInterface,
+		ForbidProtoEqual:             s.ForbidProtoEqual,
+		ForbidDeferredAssertion:      s.ForbidDeferredAssertion,
+		ForbidNumericBounds:          s.ForbidNumericBounds,
+		ForbidSQLNullNoValid:         s.ForbidSQLNullNoValid,
+		ForceHaveKeyWithValue:        s.ForceHaveKeyWithValue,
+		ForceContainElementHaveField: s.ForceContainElementHaveField,
+		ForbidAsyncDiscardedError:    s.ForbidAsyncDiscardedError,
+		ForbidImplicitTupleError:     s.ForbidImplicitTupleError,
+		ForbidContextDoneMisuse:      s.ForbidContextDoneMisuse,
+		ForbidLargeArrayEqual:        s.ForbidLargeArrayEqual,
+		LargeArrayLen:                s.LargeArrayLen,
+		ForbidFloatIntCompare:        s.ForbidFloatIntCompare,
+		ForceMatchErrorStringMatcher: s.ForceMatchErrorStringMatcher,
+		ForbidMapOrderEqual:          s.ForbidMapOrderEqual,
+		ForceFileMatchers:            s.ForceFileMatchers,
+		ForbidStrconvFormatEqual:     s.ForbidStrconvFormatEqual,
+		ForbidReversedEqual:          s.ForbidReversedEqual,
+		WarnFloatEqual:               s.WarnFloatEqual,
+		ForceSatisfyAll:              s.ForceSatisfyAll,
+		SuppressEquivalentTo:         s.SuppressEquivalentTo,
+		ForbidConsistentlyReceive:    s.ForbidConsistentlyReceive,
+		ForbidSharedMatcher:          s.ForbidSharedMatcher,
+		ForbidErrorSliceEqual:        s.ForbidErrorSliceEqual,
+		ForceWithTransform:           s.ForceWithTransform,
+		ForbidExpectWithOffset:       s.ForbidExpectWithOffset,
+		ForbidTimeStructEqual:        s.ForbidTimeStructEqual,
 	}
 }
 
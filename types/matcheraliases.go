@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nunnatsa/ginkgolinter/internal/gomegainfo"
+)
+
+// MatcherAliases maps the names of custom wrapper matchers to the gomega matchers they wrap; e.g.
+// a `MyEqual(x)` function, that returns `Equal(x)`. The wrapper matcher must receive the same
+// parameters as the gomega matcher.
+//
+// MatcherAliases implements the flag.Value interface. The flag value is a comma separated list of
+// `wrapper=matcher` pairs; e.g. `MyEqual=Equal,BeEmptyList=BeEmpty`
+type MatcherAliases map[string]string
+
+func (a *MatcherAliases) String() string {
+	if a == nil || len(*a) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(*a))
+	for wrapper, mtchr := range *a {
+		pairs = append(pairs, wrapper+"="+mtchr)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func (a *MatcherAliases) Set(value string) error {
+	aliases := MatcherAliases{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		wrapper, mtchr, found := strings.Cut(pair, "=")
+		wrapper, mtchr = strings.TrimSpace(wrapper), strings.TrimSpace(mtchr)
+		if !found || wrapper == "" || mtchr == "" {
+			return fmt.Errorf("wrong matcher alias %q; should be in the form of wrapper=matcher", pair)
+		}
+
+		if !gomegainfo.IsMatcherName(mtchr) {
+			return fmt.Errorf("wrong matcher alias %q; %q is not a known gomega matcher", pair, mtchr)
+		}
+
+		if gomegainfo.IsMatcherName(wrapper) {
+			return fmt.Errorf("wrong matcher alias %q; can't use the %q gomega matcher as an alias", pair, wrapper)
+		}
+
+		aliases[wrapper] = mtchr
+	}
+
+	*a = aliases
+
+	return nil
+}
+
+// Get returns the name of the gomega matcher, wrapped by the wrapper matcher
+func (a MatcherAliases) Get(wrapper string) (string, bool) {
+	mtchr, ok := a[wrapper]
+	return mtchr, ok
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+)
+
+func TestMatcherAliases_Set(t *testing.T) {
+	var aliases MatcherAliases
+	if err := aliases.Set("MyEqual=Equal, BeEmptyList = BeEmpty"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if m, ok := aliases.Get("MyEqual"); !ok || m != "Equal" {
+		t.Errorf(`MyEqual should be an alias of Equal; got %q`, m)
+	}
+
+	if m, ok := aliases.Get("BeEmptyList"); !ok || m != "BeEmpty" {
+		t.Errorf(`BeEmptyList should be an alias of BeEmpty; got %q`, m)
+	}
+
+	if _, ok := aliases.Get("Equal"); ok {
+		t.Error("Equal should not be an alias")
+	}
+
+	if s := aliases.String(); s != "BeEmptyList=BeEmpty,MyEqual=Equal" {
+		t.Errorf("wrong string value: %q", s)
+	}
+}
+
+func TestMatcherAliases_SetErrors(t *testing.T) {
+	for _, val := range []string{
+		"MyEqual",
+		"MyEqual=",
+		"=Equal",
+		"MyEqual=NotAMatcher",
+		"MyEqual=Not",
+		"Equal=BeNil",
+	} {
+		var aliases MatcherAliases
+		if err := aliases.Set(val); err == nil {
+			t.Errorf("%q should be rejected", val)
+		}
+	}
+}